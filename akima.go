@@ -0,0 +1,107 @@
+package interpolator
+
+import "math"
+
+// Akima is a cubic Hermite interpolator whose slopes are computed locally from the secants
+// of the neighbouring segments, so that a single data point only perturbs the nearby segments.
+// It is continuously differentiable and does not ring around outliers.
+type Akima struct {
+	*curve
+}
+
+// NewAkima builds an Akima spline interpolator, following Akima (1970).
+// The input `xys` must be ordered and have unique abscissas,
+// otherwise a *PointError is returned.
+// By default, the edge segments are extended for extrapolation.
+func NewAkima(xys XYs, opts ...Option) (*Akima, error) {
+	return newAkima("akima", xys, false, opts)
+}
+
+// NewMakima builds a modified Akima spline interpolator, whose slopes are also weighted by the magnitude
+// of the secants, so that the interpolation stays flat on plateaus and does not overshoot
+// where consecutive secants are equal.
+// The input `xys` must be ordered and have unique abscissas,
+// otherwise a *PointError is returned.
+// By default, the edge segments are extended for extrapolation.
+func NewMakima(xys XYs, opts ...Option) (*Akima, error) {
+	return newAkima("makima", xys, true, opts)
+}
+
+func newAkima(name string, xys XYs, modified bool, opts []Option) (*Akima, error) {
+	cfg := newConfig(ExtrapolateEdge(), opts)
+
+	xys, err := cfg.prepare(name, xys)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := newHermiteCurve(xys, akimaSlopes(xys, modified), cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Akima{
+		curve: c,
+	}, nil
+}
+
+// Value computes the value of f(x) based on Akima interpolation.
+func (interp Akima) Value(x float64) float64 {
+	return interp.value(x)
+}
+
+// Gradient computes the gradient of f(x) based on Akima interpolation.
+func (interp Akima) Gradient(x float64) float64 {
+	return interp.gradient(x)
+}
+
+// SecondDerivative computes the second derivative of f(x) based on Akima interpolation,
+// which is discontinuous at the data points.
+func (interp Akima) SecondDerivative(x float64) float64 {
+	return interp.secondDerivative(x)
+}
+
+// akimaSlopes returns the first derivatives at the data points of the Akima spline going through the `xys`,
+// or of the modified Akima spline.
+func akimaSlopes(xys XYs, modified bool) []float64 {
+	n := len(xys)
+	slopes := make([]float64, n)
+	if n < 2 {
+		return slopes
+	}
+
+	if n == 2 {
+		delta := (xys[1].Y - xys[0].Y) / (xys[1].X - xys[0].X)
+		slopes[0], slopes[1] = delta, delta
+
+		return slopes
+	}
+
+	// The secants of the segments, shifted by two and extended by two quadratic
+	// extrapolations on each side.
+	delta := make([]float64, n+3)
+	for i := 0; i < n-1; i++ {
+		delta[i+2] = (xys[i+1].Y - xys[i].Y) / (xys[i+1].X - xys[i].X)
+	}
+	delta[1] = 2.0*delta[2] - delta[3]
+	delta[0] = 2.0*delta[1] - delta[2]
+	delta[n+1] = 2.0*delta[n] - delta[n-1]
+	delta[n+2] = 2.0*delta[n+1] - delta[n]
+
+	for i := range slopes {
+		m1, m2, m3, m4 := delta[i], delta[i+1], delta[i+2], delta[i+3]
+		w1, w2 := math.Abs(m4-m3), math.Abs(m2-m1)
+		if modified {
+			w1 += 0.5 * math.Abs(m4+m3)
+			w2 += 0.5 * math.Abs(m2+m1)
+		}
+
+		if w1+w2 == 0.0 {
+			slopes[i] = 0.5 * (m2 + m3)
+		} else {
+			slopes[i] = (w1*m2 + w2*m3) / (w1 + w2)
+		}
+	}
+
+	return slopes
+}
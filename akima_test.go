@@ -0,0 +1,172 @@
+package interpolator
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAkimaEmptyXYs(t *testing.T) {
+	_, err := NewAkima(XYs{})
+	require.ErrorIs(t, err, ErrNotEnoughPoints)
+
+	_, err = NewMakima(XYs{})
+	require.ErrorIs(t, err, ErrNotEnoughPoints)
+}
+
+func TestNewAkimaUnsortedXYs(t *testing.T) {
+	_, err := NewAkima(XYs{
+		{
+			X: 1.0,
+			Y: 1.0,
+		},
+		{
+			X: 0.0,
+			Y: 1.0,
+		},
+	})
+	require.ErrorIs(t, err, ErrUnsorted)
+}
+
+func TestNewAkimaSinglePoint(t *testing.T) {
+	const tol = 1e-15
+
+	interpolator, err := NewAkima(XYs{
+		{
+			X: 0.0,
+			Y: 1.0,
+		},
+	})
+	require.NoError(t, err)
+
+	assert.InDelta(t, 1.0, interpolator.Value(-1.0), tol)
+	assert.InDelta(t, 1.0, interpolator.Value(0.0), tol)
+	assert.InDelta(t, 1.0, interpolator.Value(1.0), tol)
+
+	assert.InDelta(t, 0.0, interpolator.Gradient(-1.0), tol)
+	assert.InDelta(t, 0.0, interpolator.Gradient(0.0), tol)
+	assert.InDelta(t, 0.0, interpolator.Gradient(1.0), tol)
+}
+
+func TestAkimaGradient(t *testing.T) {
+	tolerance := 1.0e-12
+	xys := XYs{{X: 0.0, Y: 0.0}, {X: 1.0, Y: 1.0}, {X: 2.0, Y: 3.0}, {X: 3.0, Y: 4.0}, {X: 4.0, Y: 4.0}}
+
+	akima, err := NewAkima(xys)
+	require.NoError(t, err)
+	makima, err := NewMakima(xys)
+	require.NoError(t, err)
+
+	// The secants are 1, 2, 1 and 0, extended by 0 and -1 on the left.
+	assert.InDelta(t, 1.5, akima.Gradient(1.0), tolerance)
+	assert.InDelta(t, 1.375, makima.Gradient(1.0), tolerance)
+	assert.InDelta(t, 1.5, akima.Gradient(2.0), tolerance)
+	assert.InDelta(t, 1.375, makima.Gradient(2.0), tolerance)
+}
+
+func TestAkimaPlateau(t *testing.T) {
+	xys := XYs{{X: 0.0, Y: 0.0}, {X: 1.0, Y: 0.0}, {X: 2.0, Y: 0.0}, {X: 3.0, Y: 1.0}, {X: 4.0, Y: 1.0}, {X: 5.0, Y: 1.0}}
+
+	for name, ctor := range map[string]func(XYs, ...Option) (*Akima, error){"Akima": NewAkima, "Makima": NewMakima} {
+		ctor := ctor
+		t.Run(name, func(t *testing.T) {
+			interpolator, err := ctor(xys)
+			require.NoError(t, err)
+
+			for _, x := range []float64{0.5, 1.5, 2.0, 3.0, 3.5, 4.5} {
+				assert.Equal(t, math.Round(x/5.0), interpolator.Value(x), "x=%v", x)
+			}
+			assert.InDelta(t, 0.5, interpolator.Value(2.5), 1.0e-15)
+		})
+	}
+}
+
+func TestAkimaLocality(t *testing.T) {
+	xs := testGrid(0.0, 9.0, 10)
+	ys := make([]float64, len(xs))
+	for i, x := range xs {
+		ys[i] = math.Sin(x)
+	}
+	xys, err := NewXYs(xs, ys)
+	require.NoError(t, err)
+
+	outlier := xys.Copy()
+	outlier[5].Y += 10.0
+
+	for name, ctor := range map[string]func(XYs, ...Option) (*Akima, error){"Akima": NewAkima, "Makima": NewMakima} {
+		ctor := ctor
+		t.Run(name, func(t *testing.T) {
+			reference, err := ctor(xys)
+			require.NoError(t, err)
+			perturbed, err := ctor(outlier)
+			require.NoError(t, err)
+
+			// The segment [x_i, x_i+1] only depends on the points i-2 to i+3.
+			for _, x := range append(testGrid(0.0, 2.0, 21), testGrid(8.0, 9.0, 11)...) {
+				assert.Equal(t, reference.Value(x), perturbed.Value(x), "x=%v", x)
+			}
+			assert.NotEqual(t, reference.Value(2.5), perturbed.Value(2.5))
+		})
+	}
+}
+
+func TestAkimaLinearXYs(t *testing.T) {
+	for _, ctor := range []func(XYs, ...Option) (*Akima, error){NewAkima, NewMakima} {
+		interpolator, err := ctor(testLinearXYs)
+		require.NoError(t, err)
+		for _, x := range []float64{-1.0, 0.3, 0.7, 1.2, 1.6, 3.0} {
+			assert.InDelta(t, testLinearFunc(x), interpolator.Value(x), 1.0e-12)
+			assert.InDelta(t, 1.2, interpolator.Gradient(x), 1.0e-12)
+		}
+	}
+}
+
+func ExampleAkima_Value() {
+	xys := XYs{
+		{
+			X: 0.0,
+			Y: 0.0,
+		},
+		{
+			X: 1.0,
+			Y: 1.0,
+		},
+		{
+			X: 2.0,
+			Y: 3.0,
+		},
+		{
+			X: 3.0,
+			Y: 4.0,
+		},
+		{
+			X: 4.0,
+			Y: 4.0,
+		},
+	}
+	interp, err := NewAkima(xys)
+	if err != nil {
+		return
+	}
+	fmt.Println(interp.Value(1.5))
+	// Output: 2
+}
+
+func BenchmarkAkimaValue(b *testing.B) {
+	interpolator, err := NewAkima(testLinearXYs)
+	require.NoError(b, err)
+	var (
+		x = 0.7
+		y = testLinearFunc(0.7)
+		v float64
+	)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		v = interpolator.Value(x)
+	}
+	b.StopTimer()
+	assert.InDelta(b, y, v, 1.0e-12)
+}
@@ -0,0 +1,101 @@
+package interpolator
+
+import "math"
+
+// nodeTolerance is the distance to a data point, relative to the width of the domain,
+// below which the derivatives of barycentric interpolators are those at the data point.
+const nodeTolerance = 1.0e-8
+
+// barycentric is the law of the rational function going through the data points
+// with the given barycentric weights, in the second (true) barycentric form
+// r(x) = Σ w_j y_j / (x - x_j) / Σ w_j / (x - x_j).
+// The derivatives are computed with the formulas of Schneider and Werner (1986).
+type barycentric struct {
+	xys     XYs
+	weights []float64
+}
+
+func (b barycentric) value(x float64) float64 {
+	num, den := 0.0, 0.0
+	for j, xy := range b.xys {
+		d := x - xy.X
+		if d == 0.0 {
+			return xy.Y
+		}
+		c := b.weights[j] / d
+		num += c * xy.Y
+		den += c
+	}
+
+	return num / den
+}
+
+// derivatives returns the value, and the first and second derivatives at x.
+// Close to a data point, the divided differences cancel out, and the derivatives
+// are extrapolated from the ones at the data point instead.
+func (b barycentric) derivatives(x float64) (float64, float64, float64) {
+	tol := nodeTolerance * (b.xys[len(b.xys)-1].X - b.xys[0].X)
+	for i, xy := range b.xys {
+		if d := x - xy.X; math.Abs(d) <= tol {
+			_, d1, d2 := b.nodeDerivatives(i)
+
+			return b.value(x), d1 + d2*d, d2
+		}
+	}
+
+	r := b.value(x)
+
+	// With the divided differences r[x, x_j] and r[x, x, x_j], r'(x) is Σ c_j r[x, x_j] / Σ c_j
+	// and r''(x)/2 is Σ c_j r[x, x, x_j] / Σ c_j, where c_j = w_j / (x - x_j).
+	den, d1 := 0.0, 0.0
+	for j, xy := range b.xys {
+		d := x - xy.X
+		c := b.weights[j] / d
+		den += c
+		d1 += c * (r - xy.Y) / d
+	}
+	d1 /= den
+
+	d2 := 0.0
+	for j, xy := range b.xys {
+		d := x - xy.X
+		d2 += b.weights[j] / d * (d1 - (r-xy.Y)/d) / d
+	}
+
+	return r, d1, 2.0 * d2 / den
+}
+
+// nodeDerivatives returns the value, and the first and second derivatives at the data point i,
+// where r^(k)(x_i)/k! is -Σ w_j r[x_i (k times), x_j] / w_i over the other points j.
+func (b barycentric) nodeDerivatives(i int) (float64, float64, float64) {
+	xi, yi := b.xys[i].X, b.xys[i].Y
+
+	d1 := 0.0
+	for j, xy := range b.xys {
+		if j != i {
+			d1 -= b.weights[j] * (yi - xy.Y) / (xi - xy.X)
+		}
+	}
+	d1 /= b.weights[i]
+
+	d2 := 0.0
+	for j, xy := range b.xys {
+		if j != i {
+			d := xi - xy.X
+			d2 -= b.weights[j] * (d1 - (yi-xy.Y)/d) / d
+		}
+	}
+
+	return yi, d1, 2.0 * d2 / b.weights[i]
+}
+
+// weightScale returns the factor applied to the differences of abscissas when computing the weights,
+// which keeps the products of differences away from overflow and underflow.
+// A common factor of all the weights does not change the interpolator.
+func weightScale(xys XYs) float64 {
+	if width := xys[len(xys)-1].X - xys[0].X; width > 0.0 {
+		return 4.0 / width
+	}
+
+	return 1.0
+}
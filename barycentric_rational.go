@@ -0,0 +1,105 @@
+package interpolator
+
+import "fmt"
+
+// defaultBlending is the default degree of the polynomials blended by barycentric rational interpolators.
+const defaultBlending = 3
+
+// BarycentricRational is the barycentric rational interpolator of Floater and Hormann (2007),
+// which blends the polynomials of degree d going through d+1 consecutive data points.
+// It has no poles on the real line, and converges like h^(d+1) for smooth data
+// without the oscillations of polynomial interpolation on evenly spaced points.
+// It is evaluated in O(n) after a setup in O(n d²).
+type BarycentricRational struct {
+	law      barycentric
+	blending int
+}
+
+// NewBarycentricRational builds a barycentric rational interpolator.
+// The input `xys` must be ordered and have unique abscissas,
+// otherwise a *PointError is returned.
+// By default, polynomials of degree 3 are blended, see WithBlending.
+// The interpolator is defined on the whole real line, and the extrapolation options do not apply.
+func NewBarycentricRational(xys XYs, opts ...Option) (*BarycentricRational, error) {
+	cfg := newConfig(ExtrapolateEdge(), opts)
+
+	xys, err := cfg.prepare("barycentric rational", xys)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.blending < 0 {
+		return nil, fmt.Errorf("invalid blending degree %d", cfg.blending)
+	}
+
+	d := min(cfg.blending, len(xys)-1)
+
+	return &BarycentricRational{
+		law: barycentric{
+			xys:     xys,
+			weights: floaterHormannWeights(xys, d),
+		},
+		blending: d,
+	}, nil
+}
+
+// Value computes the value of f(x) based on barycentric rational interpolation.
+func (interp BarycentricRational) Value(x float64) float64 {
+	return interp.law.value(x)
+}
+
+// Gradient computes the gradient of f(x) based on barycentric rational interpolation.
+func (interp BarycentricRational) Gradient(x float64) float64 {
+	_, d1, _ := interp.law.derivatives(x)
+
+	return d1
+}
+
+// SecondDerivative computes the second derivative of f(x) based on barycentric rational interpolation.
+func (interp BarycentricRational) SecondDerivative(x float64) float64 {
+	_, _, d2 := interp.law.derivatives(x)
+
+	return d2
+}
+
+// Blending returns the degree of the blended polynomials,
+// which is at most the number of data points minus 1.
+func (interp BarycentricRational) Blending() int {
+	return interp.blending
+}
+
+// Domain returns the smallest and largest abscissas of the input data.
+func (interp BarycentricRational) Domain() (float64, float64) {
+	return interp.law.xys.Domain()
+}
+
+// Points returns a copy of the input data.
+func (interp BarycentricRational) Points() XYs {
+	return interp.law.xys.Copy()
+}
+
+// floaterHormannWeights returns the barycentric weights blending the polynomials of degree d:
+// w_k is the sum over i of (-1)^i / Π (x_k - x_j), for j from i to i+d except k,
+// over the i from max(0, k-d) to min(k, n-d), n+1 being the number of points.
+func floaterHormannWeights(xys XYs, d int) []float64 {
+	n := len(xys) - 1
+	scale := weightScale(xys)
+
+	weights := make([]float64, n+1)
+	for k := range weights {
+		for i := max(0, k-d); i <= min(k, n-d); i++ {
+			term := 1.0
+			for j := i; j <= i+d; j++ {
+				if j != k {
+					term /= scale * (xys[k].X - xys[j].X)
+				}
+			}
+			if i%2 == 1 {
+				term = -term
+			}
+			weights[k] += term
+		}
+	}
+
+	return weights
+}
@@ -0,0 +1,191 @@
+package interpolator
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testEvenXYs samples f at n evenly spaced points on [-1, 1].
+func testEvenXYs(n int, f func(float64) float64) XYs {
+	xys := make(XYs, n)
+	for i, x := range testGrid(-1.0, 1.0, n) {
+		xys[i] = XY{X: x, Y: f(x)}
+	}
+
+	return xys
+}
+
+func TestNewBarycentricRationalEmptyXYs(t *testing.T) {
+	_, err := NewBarycentricRational(XYs{})
+	require.ErrorIs(t, err, ErrNotEnoughPoints)
+}
+
+func TestNewBarycentricRationalInvalidBlending(t *testing.T) {
+	_, err := NewBarycentricRational(testCubicXYs, WithBlending(-1))
+	require.EqualError(t, err, "invalid blending degree -1")
+}
+
+func TestBarycentricRationalPolynomial(t *testing.T) {
+	const tol = 1.0e-10
+
+	// With a degree of at least the number of points minus 1, the interpolator is the polynomial.
+	interpolator, err := NewBarycentricRational(testCubicXYs, WithBlending(10))
+	require.NoError(t, err)
+	assert.Equal(t, len(testCubicXYs)-1, interpolator.Blending())
+
+	polynomial, err := NewPolynomial(testCubicXYs)
+	require.NoError(t, err)
+
+	for _, x := range testGrid(-1.0, 4.0, 51) {
+		assert.InDelta(t, polynomial.Value(x), interpolator.Value(x), tol, "x=%v", x)
+		assert.InDelta(t, polynomial.Gradient(x), interpolator.Gradient(x), tol, "x=%v", x)
+		assert.InDelta(t, polynomial.SecondDerivative(x), interpolator.SecondDerivative(x), tol, "x=%v", x)
+	}
+}
+
+func TestBarycentricRationalCubic(t *testing.T) {
+	const tol = 1.0e-10
+
+	xys := make(XYs, 21)
+	for i, x := range testGrid(0.0, 3.0, len(xys)) {
+		y, _, _ := testCubicFunc(x)
+		xys[i] = XY{X: x, Y: y}
+	}
+
+	interpolator, err := NewBarycentricRational(xys)
+	require.NoError(t, err)
+	assert.Equal(t, 3, interpolator.Blending())
+
+	for _, x := range testGrid(0.0, 3.0, 101) {
+		y, d1, d2 := testCubicFunc(x)
+		assert.InDelta(t, y, interpolator.Value(x), tol, "x=%v", x)
+		assert.InDelta(t, d1, interpolator.Gradient(x), tol, "x=%v", x)
+		assert.InDelta(t, d2, interpolator.SecondDerivative(x), 1.0e3*tol, "x=%v", x)
+	}
+
+	xMin, xMax := interpolator.Domain()
+	assert.Equal(t, 0.0, xMin)
+	assert.Equal(t, 3.0, xMax)
+	assert.Equal(t, xys, interpolator.Points())
+}
+
+func TestBarycentricRationalRunge(t *testing.T) {
+	xys := testEvenXYs(41, testRunge)
+
+	rational, err := NewBarycentricRational(xys)
+	require.NoError(t, err)
+	polynomial, err := NewPolynomial(xys)
+	require.NoError(t, err)
+
+	rationalErr, polynomialErr := 0.0, 0.0
+	for _, x := range testGrid(-1.0, 1.0, 401) {
+		y := testRunge(x)
+		rationalErr = math.Max(rationalErr, math.Abs(rational.Value(x)-y))
+		polynomialErr = math.Max(polynomialErr, math.Abs(polynomial.Value(x)-y))
+	}
+
+	// The polynomial oscillates near the ends of the domain, and the rational function does not.
+	assert.Less(t, rationalErr, 1.0e-3)
+	assert.Greater(t, polynomialErr, 1.0)
+}
+
+func TestBarycentricRationalBlending(t *testing.T) {
+	testCases := []struct {
+		name      string
+		blending  int
+		tolerance float64
+	}{
+		{
+			"Berrut",
+			0,
+			0.2,
+		},
+		{
+			"Linear",
+			1,
+			5.0e-3,
+		},
+		{
+			"Default",
+			defaultBlending,
+			1.0e-4,
+		},
+	}
+	xys := testEvenXYs(11, math.Exp)
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			interpolator, err := NewBarycentricRational(xys, WithBlending(tc.blending))
+			require.NoError(t, err)
+			assert.Equal(t, tc.blending, interpolator.Blending())
+
+			for _, xy := range xys {
+				assert.Equal(t, xy.Y, interpolator.Value(xy.X))
+			}
+			for _, x := range testGrid(-1.0, 1.0, 201) {
+				assert.InDelta(t, math.Exp(x), interpolator.Value(x), tc.tolerance, "x=%v", x)
+			}
+		})
+	}
+}
+
+func TestBarycentricRationalDerivatives(t *testing.T) {
+	const h = 1.0e-5
+
+	interpolator, err := NewBarycentricRational(testEvenXYs(15, math.Sin))
+	require.NoError(t, err)
+
+	for _, x := range append(testGrid(-0.97, 0.97, 11), interpolator.Points()[4].X, 1.5) {
+		gradient := (interpolator.Value(x+h) - interpolator.Value(x-h)) / (2.0 * h)
+		second := (interpolator.Gradient(x+h) - interpolator.Gradient(x-h)) / (2.0 * h)
+		assert.InDelta(t, gradient, interpolator.Gradient(x), 1.0e-8, "x=%v", x)
+		assert.InDelta(t, second, interpolator.SecondDerivative(x), 1.0e-6, "x=%v", x)
+	}
+}
+
+func ExampleBarycentricRational_Value() {
+	xys := XYs{
+		{
+			X: 0.0,
+			Y: 0.0,
+		},
+		{
+			X: 1.0,
+			Y: 1.0,
+		},
+		{
+			X: 2.0,
+			Y: 4.0,
+		},
+		{
+			X: 3.0,
+			Y: 9.0,
+		},
+	}
+	interp, err := NewBarycentricRational(xys, WithBlending(2))
+	if err != nil {
+		return
+	}
+	fmt.Printf("%.4f\n", interp.Value(1.5))
+	// Output: 2.2500
+}
+
+func BenchmarkBarycentricRationalValue(b *testing.B) {
+	interpolator, err := NewBarycentricRational(testCubicXYs)
+	require.NoError(b, err)
+	var (
+		x       = 1.3
+		y, _, _ = testCubicFunc(x)
+		v       float64
+	)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		v = interpolator.Value(x)
+	}
+	b.StopTimer()
+	assert.InDelta(b, y, v, 1.0e-12)
+}
@@ -0,0 +1,72 @@
+package interpolator
+
+import "math"
+
+// ValuesInto computes the values f(x) at each of the `xs` into `dst`.
+// It panics if `dst` and `xs` do not have the same length.
+// Sorted `xs` are evaluated in a single walk through the segments,
+// in O(n+m) instead of O(m log n) for m abscissas and n data points.
+func (c *curve) ValuesInto(dst, xs []float64) {
+	c.evaluateInto(dst, xs, piece.value)
+}
+
+// GradientsInto computes the gradients f'(x) at each of the `xs` into `dst`.
+// It panics if `dst` and `xs` do not have the same length.
+// Sorted `xs` are evaluated in a single walk through the segments,
+// in O(n+m) instead of O(m log n) for m abscissas and n data points.
+func (c *curve) GradientsInto(dst, xs []float64) {
+	c.evaluateInto(dst, xs, piece.gradient)
+}
+
+func (c *curve) evaluateInto(dst, xs []float64, eval func(piece, float64) float64) {
+	if len(dst) != len(xs) {
+		panic("interpolator: destination and abscissas have different lengths")
+	}
+
+	if !isSorted(xs) {
+		for i, x := range xs {
+			dst[i] = eval(c.at(x), x)
+		}
+
+		return
+	}
+
+	xMin, xMax := c.Domain()
+	last := len(c.pieces) - 1
+	segment := -1
+	for i, x := range xs {
+		switch {
+		case math.IsNaN(x):
+			dst[i] = eval(nanPiece{}, x)
+		case x < xMin:
+			dst[i] = eval(c.left, x)
+		case x > xMax:
+			dst[i] = eval(c.right, x)
+		case x == xMax && c.end != nil:
+			dst[i] = eval(c.end, x)
+		default:
+			if segment < 0 {
+				segment = c.index(x)
+			}
+			for segment < last && c.xs[segment+1] <= x {
+				segment++
+			}
+			dst[i] = eval(c.pieces[segment], x)
+		}
+	}
+}
+
+// isSorted reports whether the values are in increasing order, ignoring NaN values.
+func isSorted(xs []float64) bool {
+	last := math.Inf(-1)
+	for _, x := range xs {
+		if x < last {
+			return false
+		}
+		if !math.IsNaN(x) {
+			last = x
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,166 @@
+package interpolator
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testBuiltinMethods = []string{
+	MethodPiecewiseConstant,
+	MethodPiecewiseLinear,
+	MethodPiecewiseLinearThreshold,
+	MethodPiecewiseLinearSqrt,
+	MethodGeometric,
+	MethodGeometricSqrt,
+	MethodCubicSpline,
+	MethodMonotoneCubic,
+	MethodAkima,
+	MethodMakima,
+	MethodSmoothingSpline,
+	MethodMonotoneConvex,
+}
+
+// testNew builds an interpolator with the `method`, shifting the ordinates so that the curve
+// starts at the origin for the monotone convex method, which requires it if the data starts at 0.
+func testNew(method string, xys XYs, opts ...Option) (Interpolator, error) {
+	if method == MethodMonotoneConvex && len(xys) > 0 && xys[0].X == 0.0 {
+		shifted := xys.Copy()
+		for i := range shifted {
+			shifted[i].Y -= xys[0].Y
+		}
+		xys = shifted
+	}
+
+	return New(method, xys, opts...)
+}
+
+// testGrid returns n evenly spaced abscissas on [a, b].
+func testGrid(a, b float64, n int) []float64 {
+	xs := make([]float64, n)
+	for i := range xs {
+		xs[i] = a + (b-a)*float64(i)/float64(n-1)
+	}
+
+	return xs
+}
+
+func TestValuesInto(t *testing.T) {
+	sorted := testGrid(-1.0, 3.0, 101)
+	unsorted := []float64{1.3, -0.5, 2.0, 0.0, 0.5, 2.5, 0.25}
+
+	for _, method := range testBuiltinMethods {
+		method := method
+		t.Run(method, func(t *testing.T) {
+			interp, err := testNew(method, testExpXYs)
+			require.NoError(t, err)
+
+			vectorized, ok := interp.(Vectorized)
+			require.True(t, ok)
+
+			for _, xs := range [][]float64{sorted, unsorted} {
+				values := make([]float64, len(xs))
+				gradients := make([]float64, len(xs))
+				vectorized.ValuesInto(values, xs)
+				vectorized.GradientsInto(gradients, xs)
+
+				for i, x := range xs {
+					assert.InDelta(t, interp.Value(x), values[i], 1.0e-15)
+					assert.InDelta(t, interp.Gradient(x), gradients[i], 1.0e-15)
+				}
+			}
+		})
+	}
+}
+
+func TestValuesIntoNaN(t *testing.T) {
+	nan := math.NaN()
+
+	for _, method := range testBuiltinMethods {
+		method := method
+		t.Run(method, func(t *testing.T) {
+			interp, err := testNew(method, testExpXYs)
+			require.NoError(t, err)
+
+			assert.True(t, math.IsNaN(interp.Value(nan)))
+			assert.True(t, math.IsNaN(interp.Gradient(nan)))
+			assert.True(t, math.IsNaN(interp.(TwiceDifferentiable).SecondDerivative(nan)))
+
+			// The NaN values do not hide the order of the other abscissas.
+			for _, xs := range [][]float64{{0.5, nan, 1.5}, {1.5, nan, 0.5}, {nan, -1.0, 2.5}} {
+				values := make([]float64, len(xs))
+				interp.(Vectorized).ValuesInto(values, xs)
+				for i, x := range xs {
+					if math.IsNaN(x) {
+						assert.True(t, math.IsNaN(values[i]))
+					} else {
+						assert.InDelta(t, interp.Value(x), values[i], 1.0e-15, "x=%v", x)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestValuesIntoAllocations(t *testing.T) {
+	interpolator, err := NewGeometric(testExpXYs)
+	require.NoError(t, err)
+
+	xs := testGrid(-1.0, 3.0, 101)
+	dst := make([]float64, len(xs))
+
+	allocs := testing.AllocsPerRun(10, func() {
+		interpolator.ValuesInto(dst, xs)
+	})
+	assert.Zero(t, allocs)
+}
+
+func TestValuesIntoLengthMismatch(t *testing.T) {
+	interpolator, err := NewPiecewiseLinear(testLinearXYs)
+	require.NoError(t, err)
+
+	assert.Panics(t, func() {
+		interpolator.ValuesInto(make([]float64, 1), []float64{0.0, 1.0})
+	})
+}
+
+func benchmarkCurve(b *testing.B) *PiecewiseLinear {
+	b.Helper()
+
+	xs := testGrid(0.0, 10.0, 1000)
+	ys := make([]float64, len(xs))
+	for i, x := range xs {
+		ys[i] = math.Sin(x)
+	}
+	xys, err := NewXYs(xs, ys)
+	require.NoError(b, err)
+
+	interpolator, err := NewPiecewiseLinear(xys)
+	require.NoError(b, err)
+
+	return interpolator
+}
+
+func BenchmarkPiecewiseLinearValueGrid(b *testing.B) {
+	interpolator := benchmarkCurve(b)
+	xs := testGrid(0.0, 10.0, 10000)
+	dst := make([]float64, len(xs))
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for i, x := range xs {
+			dst[i] = interpolator.Value(x)
+		}
+	}
+}
+
+func BenchmarkPiecewiseLinearValuesInto(b *testing.B) {
+	interpolator := benchmarkCurve(b)
+	xs := testGrid(0.0, 10.0, 10000)
+	dst := make([]float64, len(xs))
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		interpolator.ValuesInto(dst, xs)
+	}
+}
@@ -0,0 +1,50 @@
+package interpolator
+
+import "errors"
+
+var (
+	// ErrNotPeriodic is returned when building a periodic spline from data points
+	// whose first and last ordinates differ.
+	ErrNotPeriodic = errors.New("data is not periodic")
+	// ErrInvalidBoundary is returned when the boundary conditions of a spline are inconsistent.
+	ErrInvalidBoundary = errors.New("invalid boundary condition")
+)
+
+type boundaryKind int
+
+const (
+	naturalBoundary boundaryKind = iota
+	clampedBoundary
+	notAKnotBoundary
+	periodicBoundary
+)
+
+// Boundary defines the condition imposed on a spline at an end of the domain of its input data.
+// The zero value is a natural condition.
+type Boundary struct {
+	kind  boundaryKind
+	slope float64
+}
+
+// BoundaryNatural imposes a zero second derivative at the end point.
+func BoundaryNatural() Boundary {
+	return Boundary{kind: naturalBoundary}
+}
+
+// BoundaryClamped imposes the given first derivative at the end point.
+func BoundaryClamped(slope float64) Boundary {
+	return Boundary{kind: clampedBoundary, slope: slope}
+}
+
+// BoundaryNotAKnot imposes a continuous third derivative at the data point next to the end point,
+// so that the two edge segments follow the same cubic law.
+// With only two data points, the natural condition is used instead.
+func BoundaryNotAKnot() Boundary {
+	return Boundary{kind: notAKnotBoundary}
+}
+
+// BoundaryPeriodic imposes the same first and second derivatives at both end points.
+// It must be set on both sides, and the first and last ordinates must be equal.
+func BoundaryPeriodic() Boundary {
+	return Boundary{kind: periodicBoundary}
+}
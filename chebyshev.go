@@ -0,0 +1,270 @@
+package interpolator
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	// defaultTolerance is the default tolerance of Chebyshev approximations, relative to the magnitude of the function.
+	defaultTolerance = 1.0e-13
+	// defaultMaxDegree is the default maximum degree of Chebyshev approximations.
+	defaultMaxDegree = 1 << 12
+	// minChebyshevDegree is the degree of the first Chebyshev approximation, which is then doubled.
+	minChebyshevDegree = 16
+)
+
+// Chebyshev approximates a function on [a, b] by a finite Chebyshev expansion Σ c_k T_k(t),
+// where t = (2x - a - b) / (b - a), computed from the values of the function at Chebyshev points.
+// For smooth functions, the error decreases exponentially with the degree, so that
+// a few tens of evaluations of the function usually reach the machine precision.
+// It is evaluated in O(n) with the Clenshaw recurrence, as well as its derivatives and integrals.
+type Chebyshev struct {
+	a, b   float64
+	coeffs []float64
+	// d1 and d2 are the coefficients of the first and second derivatives with respect to x.
+	d1 []float64
+	d2 []float64
+}
+
+// NewChebyshev builds the Chebyshev approximation of `f` on [a, b] to the given tolerance,
+// relative to the largest absolute value of `f` at the sampled points, see WithTolerance:
+// the degree is doubled from 16 until the last coefficients of the expansion fall below the tolerance,
+// and the negligible coefficients are then dropped. An error wrapping ErrNotConverged is returned
+// if the maximum degree is reached first, see WithMaxDegree, and an error wrapping ErrNotFinite
+// if `f` returns NaN or an infinite value.
+// Each point is sampled once, so that `f` is called at most 2^k + 1 times for a degree of 2^k.
+// The expansion is a polynomial outside of [a, b], and the extrapolation options do not apply.
+func NewChebyshev(f func(float64) float64, a, b float64, opts ...Option) (*Chebyshev, error) {
+	cfg := newConfig(ExtrapolateEdge(), opts)
+
+	if !(a < b) || math.IsInf(a, 0) || math.IsInf(b, 0) {
+		return nil, fmt.Errorf("invalid interval [%v, %v]", a, b)
+	}
+	if !(cfg.tolerance > 0.0) {
+		return nil, fmt.Errorf("invalid tolerance %v", cfg.tolerance)
+	}
+	if cfg.maxDegree < 1 {
+		return nil, fmt.Errorf("invalid maximum degree %d", cfg.maxDegree)
+	}
+
+	sample := func(t float64) (float64, error) {
+		x := 0.5 * (a + b + (b-a)*t)
+		y := f(x)
+		if math.IsNaN(y) || math.IsInf(y, 0) {
+			return 0.0, fmt.Errorf("%w: f(%v) is %v", ErrNotFinite, x, y)
+		}
+
+		return y, nil
+	}
+
+	// The values at the Chebyshev points cos(πj/n) of degree n are reused at degree 2n, as the even points.
+	var ys []float64
+	for n := min(minChebyshevDegree, cfg.maxDegree); n <= cfg.maxDegree; n *= 2 {
+		next := make([]float64, n+1)
+		for j := range next {
+			if ys != nil && j%2 == 0 {
+				next[j] = ys[j/2]
+				continue
+			}
+			y, err := sample(math.Cos(math.Pi * float64(j) / float64(n)))
+			if err != nil {
+				return nil, err
+			}
+			next[j] = y
+		}
+		ys = next
+
+		coeffs := chebyshevCoefficients(ys)
+
+		scale := 0.0
+		for _, y := range ys {
+			scale = math.Max(scale, math.Abs(y))
+		}
+		threshold := cfg.tolerance * scale
+
+		if chebyshevConverged(coeffs, threshold) {
+			last := len(coeffs) - 1
+			for last > 0 && math.Abs(coeffs[last]) <= threshold {
+				last--
+			}
+
+			return newChebyshev(a, b, coeffs[:last+1]), nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: tolerance %v not reached up to degree %d", ErrNotConverged, cfg.tolerance, cfg.maxDegree)
+}
+
+// newChebyshev builds the expansion on [a, b] with the given coefficients,
+// and computes the coefficients of its derivatives.
+func newChebyshev(a, b float64, coeffs []float64) *Chebyshev {
+	d1 := chebyshevDerivative(coeffs, 2.0/(b-a))
+
+	return &Chebyshev{
+		a:      a,
+		b:      b,
+		coeffs: coeffs,
+		d1:     d1,
+		d2:     chebyshevDerivative(d1, 2.0/(b-a)),
+	}
+}
+
+// Value computes the value of the expansion at x.
+func (c Chebyshev) Value(x float64) float64 {
+	return clenshaw(c.coeffs, c.normalize(x))
+}
+
+// Gradient computes the gradient of the expansion at x.
+func (c Chebyshev) Gradient(x float64) float64 {
+	return clenshaw(c.d1, c.normalize(x))
+}
+
+// SecondDerivative computes the second derivative of the expansion at x.
+func (c Chebyshev) SecondDerivative(x float64) float64 {
+	return clenshaw(c.d2, c.normalize(x))
+}
+
+// Integral computes the integral of the expansion between a and b, exactly.
+func (c Chebyshev) Integral(a, b float64) float64 {
+	antiderivative := c.Antiderivative()
+
+	return antiderivative.Value(b) - antiderivative.Value(a)
+}
+
+// Derivative returns the Chebyshev expansion of the derivative, on the same interval.
+func (c Chebyshev) Derivative() *Chebyshev {
+	return newChebyshev(c.a, c.b, c.d1)
+}
+
+// Antiderivative returns the Chebyshev expansion of the integral of the expansion
+// from the start of the interval, on the same interval.
+func (c Chebyshev) Antiderivative() *Chebyshev {
+	return newChebyshev(c.a, c.b, chebyshevIntegral(c.coeffs, 0.5*(c.b-c.a)))
+}
+
+// Degree returns the degree of the expansion.
+func (c Chebyshev) Degree() int {
+	return len(c.coeffs) - 1
+}
+
+// Coefficients returns a copy of the coefficients c_k of the expansion, by increasing degree.
+func (c Chebyshev) Coefficients() []float64 {
+	return append([]float64(nil), c.coeffs...)
+}
+
+// Domain returns the interval of the approximation.
+func (c Chebyshev) Domain() (float64, float64) {
+	return c.a, c.b
+}
+
+// normalize maps [a, b] to [-1, 1].
+func (c Chebyshev) normalize(x float64) float64 {
+	return (2.0*x - c.a - c.b) / (c.b - c.a)
+}
+
+// chebyshevCoefficients returns the coefficients of the polynomial of degree n interpolating
+// the values ys at the Chebyshev points cos(πj/n), by a discrete cosine transform.
+func chebyshevCoefficients(ys []float64) []float64 {
+	n := len(ys) - 1
+	if n == 0 {
+		return []float64{ys[0]}
+	}
+
+	// cos(πjk/n) only depends on jk modulo 2n.
+	cosines := make([]float64, 2*n)
+	for m := range cosines {
+		cosines[m] = math.Cos(math.Pi * float64(m) / float64(n))
+	}
+
+	coeffs := make([]float64, n+1)
+	for k := range coeffs {
+		sum := 0.5 * (ys[0] + ys[n]*cosines[(n*k)%(2*n)])
+		for j := 1; j < n; j++ {
+			sum += ys[j] * cosines[(j*k)%(2*n)]
+		}
+		coeffs[k] = 2.0 * sum / float64(n)
+	}
+	coeffs[0] *= 0.5
+	coeffs[n] *= 0.5
+
+	return coeffs
+}
+
+// chebyshevConverged reports whether the last eighth of the coefficients, and at least the last two,
+// are below the threshold: checking a single one would be fooled by even or odd functions.
+func chebyshevConverged(coeffs []float64, threshold float64) bool {
+	tail := max(2, len(coeffs)/8)
+	for _, c := range coeffs[max(0, len(coeffs)-tail):] {
+		if math.Abs(c) > threshold {
+			return false
+		}
+	}
+
+	return true
+}
+
+// chebyshevDerivative returns the coefficients of the derivative of the expansion,
+// multiplied by the given scale, from the recurrence c'_{k-1} = c'_{k+1} + 2k c_k.
+func chebyshevDerivative(coeffs []float64, scale float64) []float64 {
+	n := len(coeffs) - 1
+	if n == 0 {
+		return []float64{0.0}
+	}
+
+	// The two extra coefficients c'_n and c'_{n+1} are zero.
+	d := make([]float64, n+2)
+	for k := n; k >= 1; k-- {
+		d[k-1] = d[k+1] + 2.0*float64(k)*coeffs[k]
+	}
+	d[0] *= 0.5
+
+	d = d[:n]
+	for k := range d {
+		d[k] *= scale
+	}
+
+	return d
+}
+
+// chebyshevIntegral returns the coefficients of the integral of the expansion from -1,
+// multiplied by the given scale: the integral of T_k is T_{k+1}/(2(k+1)) - T_{k-1}/(2(k-1)).
+func chebyshevIntegral(coeffs []float64, scale float64) []float64 {
+	n := len(coeffs) - 1
+	at := func(k int) float64 {
+		if k > n {
+			return 0.0
+		}
+
+		return coeffs[k]
+	}
+
+	integral := make([]float64, n+2)
+	integral[1] = at(0) - 0.5*at(2)
+	for k := 2; k <= n+1; k++ {
+		integral[k] = (at(k-1) - at(k+1)) / (2.0 * float64(k))
+	}
+
+	// The constant term makes the integral vanish at -1, where T_k is (-1)^k.
+	sign := -1.0
+	for k := 1; k <= n+1; k++ {
+		integral[0] -= sign * integral[k]
+		sign = -sign
+	}
+
+	for k := range integral {
+		integral[k] *= scale
+	}
+
+	return integral
+}
+
+// clenshaw evaluates Σ c_k T_k(t) with the Clenshaw recurrence b_k = c_k + 2t b_{k+1} - b_{k+2}.
+func clenshaw(coeffs []float64, t float64) float64 {
+	b1, b2 := 0.0, 0.0
+	for k := len(coeffs) - 1; k >= 1; k-- {
+		b1, b2 = coeffs[k]+2.0*t*b1-b2, b1
+	}
+
+	return coeffs[0] + t*b1 - b2
+}
@@ -0,0 +1,269 @@
+package interpolator
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testCubic(x float64) float64 {
+	y, _, _ := testCubicFunc(x)
+
+	return y
+}
+
+func TestNewChebyshevInvalid(t *testing.T) {
+	testCases := []struct {
+		name string
+		a    float64
+		b    float64
+		opts []Option
+		err  string
+	}{
+		{
+			"EmptyInterval",
+			1.0,
+			1.0,
+			nil,
+			"invalid interval [1, 1]",
+		},
+		{
+			"InfiniteInterval",
+			0.0,
+			math.Inf(1),
+			nil,
+			"invalid interval [0, +Inf]",
+		},
+		{
+			"Tolerance",
+			0.0,
+			1.0,
+			[]Option{WithTolerance(0.0)},
+			"invalid tolerance 0",
+		},
+		{
+			"MaxDegree",
+			0.0,
+			1.0,
+			[]Option{WithMaxDegree(0)},
+			"invalid maximum degree 0",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewChebyshev(math.Exp, tc.a, tc.b, tc.opts...)
+			require.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func TestNewChebyshevNotFinite(t *testing.T) {
+	_, err := NewChebyshev(math.Log, 0.0, 1.0)
+	require.ErrorIs(t, err, ErrNotFinite)
+}
+
+func TestNewChebyshevNotConverged(t *testing.T) {
+	_, err := NewChebyshev(math.Abs, -1.0, 1.0, WithMaxDegree(64))
+	require.ErrorIs(t, err, ErrNotConverged)
+}
+
+func TestChebyshevConvergence(t *testing.T) {
+	testCases := []struct {
+		name      string
+		f         func(float64) float64
+		a         float64
+		b         float64
+		tolerance float64
+		maxDegree int
+	}{
+		{
+			"Exp",
+			math.Exp,
+			0.0,
+			2.0,
+			1.0e-13,
+			20,
+		},
+		{
+			"Sin",
+			math.Sin,
+			0.0,
+			10.0,
+			1.0e-13,
+			40,
+		},
+		{
+			"Runge",
+			testRunge,
+			-1.0,
+			1.0,
+			1.0e-12,
+			300,
+		},
+		{
+			"Wide",
+			func(x float64) float64 { return 100.0 * math.Exp(-x/50.0) },
+			0.0,
+			100.0,
+			1.0e-11,
+			30,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			interpolator, err := NewChebyshev(tc.f, tc.a, tc.b)
+			require.NoError(t, err)
+			assert.LessOrEqual(t, interpolator.Degree(), tc.maxDegree)
+
+			scale := 0.0
+			for _, x := range testGrid(tc.a, tc.b, 1001) {
+				scale = math.Max(scale, math.Abs(tc.f(x)))
+			}
+			for _, x := range testGrid(tc.a, tc.b, 1001) {
+				assert.InDelta(t, tc.f(x), interpolator.Value(x), tc.tolerance*scale, "x=%v", x)
+			}
+
+			xMin, xMax := interpolator.Domain()
+			assert.Equal(t, tc.a, xMin)
+			assert.Equal(t, tc.b, xMax)
+		})
+	}
+}
+
+func TestChebyshevEvaluations(t *testing.T) {
+	evaluations := 0
+	interpolator, err := NewChebyshev(func(x float64) float64 {
+		evaluations++
+
+		return math.Sin(x)
+	}, 0.0, 20.0)
+	require.NoError(t, err)
+
+	// The points of degrees 16 and 32 are reused at degree 64.
+	assert.Equal(t, 65, evaluations)
+	assert.Greater(t, interpolator.Degree(), 16)
+	assert.Less(t, interpolator.Degree(), 64)
+}
+
+func TestChebyshevTolerance(t *testing.T) {
+	precise, err := NewChebyshev(math.Exp, 0.0, 2.0)
+	require.NoError(t, err)
+	rough, err := NewChebyshev(math.Exp, 0.0, 2.0, WithTolerance(1.0e-4))
+	require.NoError(t, err)
+
+	assert.Less(t, rough.Degree(), precise.Degree())
+	for _, x := range testGrid(0.0, 2.0, 101) {
+		assert.InDelta(t, math.Exp(x), rough.Value(x), 1.0e-4*math.Exp(2.0), "x=%v", x)
+	}
+}
+
+func TestChebyshevConstant(t *testing.T) {
+	testCases := []struct {
+		name  string
+		value float64
+	}{
+		{
+			"Zero",
+			0.0,
+		},
+		{
+			"NonZero",
+			2.5,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			interpolator, err := NewChebyshev(func(float64) float64 { return tc.value }, -1.0, 3.0)
+			require.NoError(t, err)
+
+			assert.Equal(t, 0, interpolator.Degree())
+			assert.Equal(t, []float64{tc.value}, interpolator.Coefficients())
+			assert.Equal(t, tc.value, interpolator.Value(0.5))
+			assert.Equal(t, 0.0, interpolator.Gradient(0.5))
+			assert.Equal(t, 0.0, interpolator.SecondDerivative(0.5))
+			assert.InDelta(t, 2.0*tc.value, interpolator.Integral(0.0, 2.0), 1.0e-15)
+		})
+	}
+}
+
+func TestChebyshevCubic(t *testing.T) {
+	const tol = 1.0e-12
+
+	interpolator, err := NewChebyshev(testCubic, 0.0, 3.0)
+	require.NoError(t, err)
+	assert.Equal(t, 3, interpolator.Degree())
+
+	// The expansion is the cubic, including outside of the interval.
+	for _, x := range []float64{-1.0, 0.0, 0.4, 1.3, 2.9, 3.0, 4.0} {
+		y, d1, d2 := testCubicFunc(x)
+		assert.InDelta(t, y, interpolator.Value(x), tol, "x=%v", x)
+		assert.InDelta(t, d1, interpolator.Gradient(x), tol, "x=%v", x)
+		assert.InDelta(t, d2, interpolator.SecondDerivative(x), tol, "x=%v", x)
+	}
+
+	// The primitive of the cubic is x^4/4 - 2x^3/3 + x^2/2 + x.
+	primitive := func(x float64) float64 {
+		return x*x*x*x/4.0 - 2.0*x*x*x/3.0 + x*x/2.0 + x
+	}
+	for _, bounds := range [][2]float64{{0.0, 3.0}, {0.5, 2.0}, {2.0, 0.5}, {-1.0, 4.0}} {
+		a, b := bounds[0], bounds[1]
+		assert.InDelta(t, primitive(b)-primitive(a), interpolator.Integral(a, b), tol, "a=%v, b=%v", a, b)
+	}
+}
+
+func TestChebyshevDerivativeAntiderivative(t *testing.T) {
+	const tol = 1.0e-12
+
+	interpolator, err := NewChebyshev(math.Sin, 0.0, math.Pi)
+	require.NoError(t, err)
+
+	derivative := interpolator.Derivative()
+	assert.Equal(t, interpolator.Degree()-1, derivative.Degree())
+
+	antiderivative := interpolator.Antiderivative()
+	assert.Equal(t, interpolator.Degree()+1, antiderivative.Degree())
+	assert.InDelta(t, 0.0, antiderivative.Value(0.0), tol)
+
+	for _, x := range testGrid(0.0, math.Pi, 51) {
+		assert.InDelta(t, math.Cos(x), derivative.Value(x), 1.0e-10, "x=%v", x)
+		assert.InDelta(t, math.Cos(x), interpolator.Gradient(x), 1.0e-10, "x=%v", x)
+		assert.InDelta(t, -math.Sin(x), interpolator.SecondDerivative(x), 1.0e-8, "x=%v", x)
+		assert.InDelta(t, 1.0-math.Cos(x), antiderivative.Value(x), tol, "x=%v", x)
+		assert.InDelta(t, math.Sin(x), antiderivative.Gradient(x), tol, "x=%v", x)
+	}
+	assert.InDelta(t, 2.0, interpolator.Integral(0.0, math.Pi), tol)
+}
+
+func ExampleNewChebyshev() {
+	interp, err := NewChebyshev(math.Exp, 0.0, 1.0)
+	if err != nil {
+		return
+	}
+	fmt.Printf("%.6f\n", interp.Value(0.5))
+	fmt.Printf("%.6f\n", interp.Integral(0.0, 1.0))
+	// Output:
+	// 1.648721
+	// 1.718282
+}
+
+func BenchmarkChebyshevValue(b *testing.B) {
+	interpolator, err := NewChebyshev(math.Exp, 0.0, 2.0)
+	require.NoError(b, err)
+	var (
+		x = 1.3
+		y = math.Exp(x)
+		v float64
+	)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		v = interpolator.Value(x)
+	}
+	b.StopTimer()
+	assert.InDelta(b, y, v, 1.0e-12)
+}
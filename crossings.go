@@ -0,0 +1,124 @@
+package interpolator
+
+import "math"
+
+// Crossing is a set of abscissas where a curve crosses a level:
+// either a single abscissa, with From equal to To, where the curve reaches or jumps across the level,
+// or a plateau [From, To] on which the curve is equal to the level.
+type Crossing struct {
+	From float64
+	To   float64
+}
+
+// Crossings returns, in increasing order, all the abscissas in [a, b] where f crosses the level,
+// including the extrapolation regions. The crossings are solved exactly on each segment
+// from its interpolation law, and discontinuities across the level are reported as crossings.
+// There is no crossing if a or b is NaN.
+func (c *curve) Crossings(level, a, b float64) []Crossing {
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return nil
+	}
+	if a > b {
+		a, b = b, a
+	}
+	if a == b {
+		if c.value(a) == level {
+			return []Crossing{{From: a, To: a}}
+		}
+
+		return nil
+	}
+
+	// side is the sign of f - level just before the end of the last sub-interval,
+	// so that a jump from the level is only reported when it crosses the level.
+	var (
+		crossings []Crossing
+		previous  float64
+		side      float64
+		started   bool
+	)
+	c.walk(a, b, func(k int, u, v float64, p piece) {
+		fu, fv := c.bound(k, u, p), c.bound(k, v, p)
+		switch {
+		case fu == level:
+			crossings = append(crossings, Crossing{From: u, To: u})
+		case started && side*(fu-level) < 0.0:
+			crossings = append(crossings, Crossing{From: u, To: u})
+		}
+		crossings = p.crossings(level, u, v, fu, fv, crossings)
+		previous, side, started = fv, leftSide(p, level, v, fv), true
+	})
+	// The walk stops at the left limit of a jump at b, from where f(b) may reach or jump across the level.
+	last := previous
+	if i, ok := c.jumpAt(b); ok {
+		last = c.xys[i].Y
+	}
+	if last == level || side*(last-level) < 0.0 {
+		crossings = append(crossings, Crossing{From: b, To: b})
+	}
+
+	return mergeCrossings(crossings)
+}
+
+// leftSide returns the sign of f - level just before v on the law p, where f(v) = fv, as a value of the same sign.
+// When the law reaches the level at v, the sign is given by its first non-zero derivative at v,
+// and it is zero if the law is flat at the level.
+func leftSide(p piece, level, v, fv float64) float64 {
+	if d := fv - level; d != 0.0 {
+		return d
+	}
+	if d := -p.gradient(v); d != 0.0 {
+		return d
+	}
+
+	return p.secondDerivative(v)
+}
+
+// bound returns the value at x of the law p of the sub-interval of index k, as defined by walk.
+// The input ordinates are used at the data points, so as to avoid rounding errors.
+func (c *curve) bound(k int, x float64, p piece) float64 {
+	if k >= 0 && k < len(c.pieces) {
+		if x == c.xs[k] {
+			return c.xys[k].Y
+		}
+		if k+1 < len(c.xs) && x == c.xs[k+1] {
+			return c.xys[k+1].Y
+		}
+	}
+
+	return p.value(x)
+}
+
+// mergeCrossings merges the overlapping crossings, which must be sorted by increasing From.
+func mergeCrossings(crossings []Crossing) []Crossing {
+	if len(crossings) == 0 {
+		return nil
+	}
+
+	merged := crossings[:1]
+	for _, crossing := range crossings[1:] {
+		if last := &merged[len(merged)-1]; crossing.From <= last.To {
+			last.To = max(last.To, crossing.To)
+
+			continue
+		}
+		merged = append(merged, crossing)
+	}
+
+	return merged
+}
+
+// monotoneCrossings appends the crossings of the level inside [u, v] by the law p,
+// which is monotone on [u, v] with values fu and fv at the bounds.
+func monotoneCrossings(p inverter, level, u, v, fu, fv float64, dst []Crossing) []Crossing {
+	switch {
+	case fu == level && fv == level:
+		return append(dst, Crossing{From: u, To: v})
+	case (fu-level)*(fv-level) < 0.0:
+		x := min(max(p.inverse(level), u), v)
+
+		return append(dst, Crossing{From: x, To: x})
+	}
+
+	return dst
+}
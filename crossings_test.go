@@ -0,0 +1,337 @@
+package interpolator
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testWaveXYs = XYs{
+	{
+		X: 0.0,
+		Y: 1.0,
+	},
+	{
+		X: 1.0,
+		Y: -1.0,
+	},
+	{
+		X: 2.0,
+		Y: -1.0,
+	},
+	{
+		X: 3.0,
+		Y: 2.0,
+	},
+}
+
+func assertCrossings(t *testing.T, expected, actual []Crossing) {
+	t.Helper()
+
+	require.Len(t, actual, len(expected), "%v", actual)
+	for i := range expected {
+		assert.InDelta(t, expected[i].From, actual[i].From, 1.0e-12)
+		assert.InDelta(t, expected[i].To, actual[i].To, 1.0e-12)
+	}
+}
+
+func TestPiecewiseLinearCrossings(t *testing.T) {
+	interpolator, err := NewPiecewiseLinear(testWaveXYs)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		level    float64
+		a        float64
+		b        float64
+		expected []Crossing
+	}{
+		{
+			"Zero",
+			0.0,
+			-1.0,
+			4.0,
+			[]Crossing{{From: 0.5, To: 0.5}, {From: 2.0 + 1.0/3.0, To: 2.0 + 1.0/3.0}},
+		},
+		{
+			"Plateau",
+			-1.0,
+			-1.0,
+			4.0,
+			[]Crossing{{From: 1.0, To: 2.0}},
+		},
+		{
+			"Knot",
+			2.0,
+			0.0,
+			3.0,
+			[]Crossing{{From: 3.0, To: 3.0}},
+		},
+		{
+			"Extrapolation",
+			3.0,
+			-2.0,
+			4.0,
+			[]Crossing{{From: -1.0, To: -1.0}, {From: 10.0 / 3.0, To: 10.0 / 3.0}},
+		},
+		{
+			"ReversedBounds",
+			0.0,
+			1.0,
+			0.0,
+			[]Crossing{{From: 0.5, To: 0.5}},
+		},
+		{
+			"None",
+			5.0,
+			0.0,
+			3.0,
+			nil,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			assertCrossings(t, tc.expected, interpolator.Crossings(tc.level, tc.a, tc.b))
+		})
+	}
+}
+
+func TestPiecewiseConstantCrossings(t *testing.T) {
+	interpolator, err := NewPiecewiseConstant(XYs{{X: 0.0, Y: 1.0}, {X: 1.0, Y: 3.0}, {X: 2.0, Y: 2.0}})
+	require.NoError(t, err)
+
+	assertCrossings(t, []Crossing{{From: 1.0, To: 1.0}, {From: 2.0, To: 5.0}}, interpolator.Crossings(2.0, -1.0, 5.0))
+	assertCrossings(t, []Crossing{{From: -1.0, To: 1.0}}, interpolator.Crossings(1.0, -1.0, 5.0))
+	assertCrossings(t, []Crossing{{From: 1.0, To: 2.0}}, interpolator.Crossings(3.0, -1.0, 5.0))
+	assertCrossings(t, []Crossing{{From: 1.0, To: 1.0}, {From: 2.0, To: 2.0}}, interpolator.Crossings(2.5, -1.0, 5.0))
+}
+
+func TestPiecewiseConstantStepCrossings(t *testing.T) {
+	xys := XYs{{X: 0.0, Y: 1.0}, {X: 1.0, Y: 3.0}, {X: 2.0, Y: 2.0}}
+
+	testCases := []struct {
+		name     string
+		step     Step
+		jumps    []Crossing
+		plateaus []Crossing
+	}{
+		{
+			"Left",
+			StepLeft,
+			[]Crossing{{From: 0.0, To: 0.0}, {From: 1.0, To: 1.0}},
+			[]Crossing{{From: 0.0, To: 1.0}},
+		},
+		{
+			"Nearest",
+			StepNearest,
+			[]Crossing{{From: 0.5, To: 0.5}, {From: 1.5, To: 1.5}},
+			[]Crossing{{From: 0.5, To: 1.5}},
+		},
+		{
+			"Midpoint",
+			StepMidpoint,
+			[]Crossing{{From: 0.5, To: 0.5}, {From: 1.5, To: 1.5}},
+			[]Crossing{{From: 0.5, To: 1.5}},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			interpolator, err := NewPiecewiseConstant(xys, WithStep(tc.step), WithExtrapolation(ExtrapolateEdge()))
+			require.NoError(t, err)
+
+			assertCrossings(t, tc.jumps, interpolator.Crossings(2.5, -1.0, 5.0))
+			assertCrossings(t, tc.plateaus, interpolator.Crossings(3.0, -1.0, 5.0))
+		})
+	}
+}
+
+func TestCrossingsExtrapolationJump(t *testing.T) {
+	interpolator, err := NewPiecewiseLinear(testWaveXYs, WithRightExtrapolation(ExtrapolateConstant(10.0)))
+	require.NoError(t, err)
+
+	assertCrossings(t, []Crossing{{From: 3.0, To: 3.0}}, interpolator.Crossings(5.0, 0.0, 4.0))
+}
+
+func TestCrossingsNaN(t *testing.T) {
+	interpolator, err := NewPiecewiseLinear(XYs{{X: 0.0, Y: 0.0}, {X: 1.0, Y: 1.0}})
+	require.NoError(t, err)
+
+	assert.Empty(t, interpolator.Crossings(0.0, math.NaN(), 1.0))
+	assert.Empty(t, interpolator.Crossings(1.0, 0.0, math.NaN()))
+	assert.Empty(t, interpolator.Crossings(0.0, math.NaN(), math.NaN()))
+}
+
+func TestCrossingsJumpAtBound(t *testing.T) {
+	// The curve jumps down from 2 to 0 at 1.
+	interpolator, err := NewPiecewiseLinear(XYs{{X: 0.0, Y: 0.0}, {X: 1.0, Y: 2.0}, {X: 1.0, Y: 0.0}, {X: 2.0, Y: 1.0}}, WithJumps())
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		level    float64
+		a        float64
+		b        float64
+		expected []Crossing
+	}{
+		{
+			"ValueAtJump",
+			0.0,
+			0.5,
+			1.0,
+			[]Crossing{{From: 1.0, To: 1.0}},
+		},
+		{
+			"AcrossJump",
+			1.0,
+			0.5,
+			1.0,
+			[]Crossing{{From: 0.5, To: 0.5}, {From: 1.0, To: 1.0}},
+		},
+		{
+			"LeftLimit",
+			2.0,
+			0.0,
+			1.0,
+			nil,
+		},
+		{
+			"Inside",
+			0.5,
+			0.5,
+			1.5,
+			[]Crossing{{From: 1.0, To: 1.0}, {From: 1.5, To: 1.5}},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			assertCrossings(t, tc.expected, interpolator.Crossings(tc.level, tc.a, tc.b))
+		})
+	}
+}
+
+func TestCrossingsJumpFromLevel(t *testing.T) {
+	// The left limit of the jumps at 1 is the level, which is only crossed when the curve changes sides.
+	testCases := []struct {
+		name     string
+		xys      XYs
+		a        float64
+		b        float64
+		expected []Crossing
+	}{
+		{
+			"Up",
+			XYs{{X: 0.0, Y: 0.0}, {X: 1.0, Y: 1.0}, {X: 1.0, Y: 3.0}, {X: 2.0, Y: 4.0}},
+			0.0,
+			2.0,
+			[]Crossing{{From: 1.0, To: 1.0}},
+		},
+		{
+			"UpAtBound",
+			XYs{{X: 0.0, Y: 0.0}, {X: 1.0, Y: 1.0}, {X: 1.0, Y: 3.0}, {X: 2.0, Y: 4.0}},
+			0.5,
+			1.0,
+			[]Crossing{{From: 1.0, To: 1.0}},
+		},
+		{
+			"Down",
+			XYs{{X: 0.0, Y: 2.0}, {X: 1.0, Y: 1.0}, {X: 1.0, Y: 0.0}, {X: 2.0, Y: 0.0}},
+			0.0,
+			2.0,
+			[]Crossing{{From: 1.0, To: 1.0}},
+		},
+		{
+			"BackBelow",
+			XYs{{X: 0.0, Y: 0.0}, {X: 1.0, Y: 1.0}, {X: 1.0, Y: 0.5}, {X: 2.0, Y: 0.0}},
+			0.0,
+			2.0,
+			nil,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			interpolator, err := NewPiecewiseLinear(tc.xys, WithJumps())
+			require.NoError(t, err)
+
+			assertCrossings(t, tc.expected, interpolator.Crossings(1.0, tc.a, tc.b))
+		})
+	}
+}
+
+func TestCrossings(t *testing.T) {
+	xys := XYs{{X: 0.0, Y: 1.0}, {X: 0.5, Y: 3.0}, {X: 1.0, Y: 0.5}, {X: 1.5, Y: 2.0}, {X: 2.0, Y: 1.0}}
+
+	for _, method := range testBuiltinMethods[1:] {
+		method := method
+		t.Run(method, func(t *testing.T) {
+			// Disable smoothing so that all the curves go through the data points.
+			interp, err := testNew(method, xys, WithSmoothing(SmoothingParameter(0.0)))
+			require.NoError(t, err)
+
+			crosser, ok := interp.(Crosser)
+			require.True(t, ok)
+
+			// The levels follow the ordinates, which are shifted for the monotone convex method, see testNew.
+			shift := interp.Value(0.0) - xys[0].Y
+			for _, level := range []float64{0.7, 1.0, 1.5, 2.5} {
+				level += shift
+				crossings := crosser.Crossings(level, 0.0, 2.0)
+				require.NotEmpty(t, crossings)
+				for _, crossing := range crossings {
+					assert.InDelta(t, level, interp.Value(crossing.From), 1.0e-12)
+				}
+
+				// Check the number of crossings against a sign count on a fine grid,
+				// adding the bounds where the curve reaches the level.
+				count, grid := 0, testGrid(0.0, 2.0, 1999)
+				for _, x := range []float64{0.0, 2.0} {
+					if math.Abs(interp.Value(x)-level) < 1.0e-12 {
+						count++
+					}
+				}
+				for i := 1; i < len(grid); i++ {
+					if (interp.Value(grid[i-1])-level)*(interp.Value(grid[i])-level) < 0.0 {
+						count++
+					}
+				}
+				assert.Len(t, crossings, count, "level %v", level)
+			}
+		})
+	}
+}
+
+func ExamplePiecewiseLinear_Crossings() {
+	xys := XYs{
+		{
+			X: 0.0,
+			Y: 1.0,
+		},
+		{
+			X: 1.0,
+			Y: -1.0,
+		},
+		{
+			X: 2.0,
+			Y: -1.0,
+		},
+		{
+			X: 3.0,
+			Y: 2.0,
+		},
+	}
+	interp, err := NewPiecewiseLinear(xys)
+	if err != nil {
+		return
+	}
+	fmt.Println(interp.Crossings(0.0, 0.0, 3.0))
+	fmt.Println(interp.Crossings(-1.0, 0.0, 3.0))
+	// Output:
+	// [{0.5 0.5} {2.3333333333333335 2.3333333333333335}]
+	// [{1 2}]
+}
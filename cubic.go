@@ -0,0 +1,177 @@
+package interpolator
+
+import "math"
+
+// newHermiteCurve builds a curve from valid data points, interpolated by the cubic Hermite laws
+// matching the given `slopes` at the data points.
+func newHermiteCurve(xys XYs, slopes []float64, cfg *config) (*curve, error) {
+	pieces := make([]piece, len(xys)-1)
+	for i := range pieces {
+		pieces[i] = newCubicPiece(xys[i], xys[i+1], slopes[i], slopes[i+1])
+	}
+
+	return newCurveFromPieces(xys, cfg, pieces)
+}
+
+// cubicPiece is the cubic law c0 + c1*t + c2*t^2 + c3*t^3, with t = x - x0.
+type cubicPiece struct {
+	x0 float64
+	c0 float64
+	c1 float64
+	c2 float64
+	c3 float64
+}
+
+// newCubicPiece returns the cubic Hermite law going through p1 and p2 with slopes d1 and d2.
+func newCubicPiece(p1, p2 XY, d1, d2 float64) cubicPiece {
+	h := p2.X - p1.X
+	delta := (p2.Y - p1.Y) / h
+
+	return cubicPiece{
+		x0: p1.X,
+		c0: p1.Y,
+		c1: d1,
+		c2: (3.0*delta - 2.0*d1 - d2) / h,
+		c3: (d1 + d2 - 2.0*delta) / (h * h),
+	}
+}
+
+func (p cubicPiece) value(x float64) float64 {
+	t := x - p.x0
+
+	return math.FMA(math.FMA(math.FMA(p.c3, t, p.c2), t, p.c1), t, p.c0)
+}
+
+func (p cubicPiece) gradient(x float64) float64 {
+	t := x - p.x0
+
+	return math.FMA(math.FMA(3.0*p.c3, t, 2.0*p.c2), t, p.c1)
+}
+
+func (p cubicPiece) secondDerivative(x float64) float64 {
+	return math.FMA(6.0*p.c3, x-p.x0, 2.0*p.c2)
+}
+
+func (p cubicPiece) integral(a, b float64) float64 {
+	primitive := func(x float64) float64 {
+		t := x - p.x0
+
+		return t * math.FMA(math.FMA(math.FMA(p.c3/4.0, t, p.c2/3.0), t, p.c1/2.0), t, p.c0)
+	}
+
+	return primitive(b) - primitive(a)
+}
+
+// stationary returns the abscissas inside (u, v), in increasing order, where the gradient vanishes.
+func (p cubicPiece) stationary(u, v float64) []float64 {
+	var xs []float64
+	for _, t := range quadraticRoots(3.0*p.c3, 2.0*p.c2, p.c1) {
+		if x := p.x0 + t; x > u && x < v {
+			xs = append(xs, x)
+		}
+	}
+
+	return xs
+}
+
+func (p cubicPiece) extrema(u, v, fu, fv float64) (XY, XY) {
+	lo, hi := monotoneExtrema(u, v, fu, fv)
+	for _, x := range p.stationary(u, v) {
+		xy := XY{X: x, Y: p.value(x)}
+		lo, hi = lower(lo, xy), higher(hi, xy)
+	}
+
+	return lo, hi
+}
+
+// crossings splits [u, v] at the stationary points of the law, and solves on each monotone part.
+func (p cubicPiece) crossings(level, u, v, fu, fv float64, dst []Crossing) []Crossing {
+	if p.c1 == 0.0 && p.c2 == 0.0 && p.c3 == 0.0 {
+		return constantPiece(p.c0).crossings(level, u, v, fu, fv, dst)
+	}
+
+	s, fs := u, fu
+	for _, e := range append(p.stationary(u, v), v) {
+		fe := fv
+		if e < v {
+			fe = p.value(e)
+		}
+		if s > u && fs == level {
+			dst = append(dst, Crossing{From: s, To: s})
+		}
+		if (fs-level)*(fe-level) < 0.0 {
+			x := p.solve(level, s, e, fs)
+			dst = append(dst, Crossing{From: x, To: x})
+		}
+		s, fs = e, fe
+	}
+
+	return dst
+}
+
+// solve returns the abscissa in [u, v] where the law reaches the level, the law being monotone on [u, v]
+// and crossing the level, with value fu at u. Newton steps are used, falling back on bisection
+// whenever they leave the bracket.
+func (p cubicPiece) solve(level, u, v, fu float64) float64 {
+	const maxIterations = 100
+
+	below := fu < level
+	lo, hi := u, v
+	x := 0.5 * (lo + hi)
+	for i := 0; i < maxIterations; i++ {
+		g := p.value(x) - level
+		if g == 0.0 {
+			break
+		}
+		if (g < 0.0) == below {
+			lo = x
+		} else {
+			hi = x
+		}
+
+		mid := 0.5 * (lo + hi)
+		if mid == lo || mid == hi {
+			break
+		}
+
+		next := x - g/p.gradient(x)
+		if !(next > lo && next < hi) {
+			next = mid
+		}
+		if next == x {
+			break
+		}
+		x = next
+	}
+
+	return x
+}
+
+// quadraticRoots returns the real roots, in increasing order, of a*t^2 + b*t + c,
+// which is degenerate when a and b are zero.
+func quadraticRoots(a, b, c float64) []float64 {
+	if a == 0.0 {
+		if b == 0.0 {
+			return nil
+		}
+
+		return []float64{-c / b}
+	}
+
+	disc := b*b - 4.0*a*c
+	switch {
+	case disc < 0.0:
+		return nil
+	case disc == 0.0:
+		return []float64{-0.5 * b / a}
+	}
+
+	// Avoid the cancellation between b and the square root of the discriminant.
+	q := -0.5 * (b + math.Copysign(math.Sqrt(disc), b))
+	r1, r2 := q/a, c/q
+	if r1 > r2 {
+		r1, r2 = r2, r1
+	}
+
+	return []float64{r1, r2}
+}
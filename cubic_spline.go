@@ -0,0 +1,160 @@
+package interpolator
+
+import "fmt"
+
+// CubicSpline is a cubic spline interpolator, which is twice continuously differentiable.
+type CubicSpline struct {
+	*curve
+}
+
+// NewCubicSpline builds a cubic spline interpolator.
+// The input `xys` must be ordered and have unique abscissas,
+// otherwise a *PointError is returned.
+// By default, the boundary conditions are natural, see WithBoundary,
+// and the edge segments are extended for extrapolation.
+func NewCubicSpline(xys XYs, opts ...Option) (*CubicSpline, error) {
+	cfg := newConfig(ExtrapolateEdge(), opts)
+
+	xys, err := cfg.prepare("cubic spline", xys)
+	if err != nil {
+		return nil, err
+	}
+
+	slopes, err := splineSlopes(xys, cfg.leftBoundary, cfg.rightBoundary)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := newHermiteCurve(xys, slopes, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CubicSpline{
+		curve: c,
+	}, nil
+}
+
+// Value computes the value of f(x) based on cubic spline interpolation.
+func (interp CubicSpline) Value(x float64) float64 {
+	return interp.value(x)
+}
+
+// Gradient computes the gradient of f(x) based on cubic spline interpolation.
+func (interp CubicSpline) Gradient(x float64) float64 {
+	return interp.gradient(x)
+}
+
+// SecondDerivative computes the second derivative of f(x) based on cubic spline interpolation,
+// which is continuous.
+func (interp CubicSpline) SecondDerivative(x float64) float64 {
+	return interp.secondDerivative(x)
+}
+
+// splineSlopes returns the first derivatives at the data points of the cubic spline
+// going through the `xys` with the given boundary conditions.
+// The slopes are the solution of the tridiagonal system expressing the continuity
+// of the second derivative at the inner data points.
+func splineSlopes(xys XYs, left, right Boundary) ([]float64, error) {
+	n := len(xys)
+	if n < 2 {
+		return make([]float64, n), nil
+	}
+
+	if left.kind == periodicBoundary || right.kind == periodicBoundary {
+		if left.kind != right.kind {
+			return nil, fmt.Errorf("%w: periodic condition on a single side", ErrInvalidBoundary)
+		}
+		if xys[0].Y != xys[n-1].Y {
+			return nil, fmt.Errorf("%w: first ordinate %v differs from last ordinate %v", ErrNotPeriodic, xys[0].Y, xys[n-1].Y)
+		}
+
+		return periodicSplineSlopes(xys), nil
+	}
+
+	h := make([]float64, n-1)
+	delta := make([]float64, n-1)
+	for i := range h {
+		h[i] = xys[i+1].X - xys[i].X
+		delta[i] = (xys[i+1].Y - xys[i].Y) / h[i]
+	}
+
+	if n == 2 {
+		if left.kind == notAKnotBoundary {
+			left = BoundaryNatural()
+		}
+		if right.kind == notAKnotBoundary {
+			right = BoundaryNatural()
+		}
+	}
+
+	if n == 3 && left.kind == notAKnotBoundary && right.kind == notAKnotBoundary {
+		// Both conditions coincide: the spline is the parabola going through the three points.
+		c := (delta[1] - delta[0]) / (h[0] + h[1])
+
+		return []float64{delta[0] - c*h[0], delta[0] + c*h[0], delta[0] + c*(h[0]+2.0*h[1])}, nil
+	}
+
+	sub := make([]float64, n)
+	diag := make([]float64, n)
+	sup := make([]float64, n)
+	slopes := make([]float64, n)
+
+	switch left.kind {
+	case clampedBoundary:
+		diag[0], slopes[0] = 1.0, left.slope
+	case notAKnotBoundary:
+		d := h[0] + h[1]
+		diag[0], sup[0] = h[1], d
+		slopes[0] = ((h[0]+2.0*d)*h[1]*delta[0] + h[0]*h[0]*delta[1]) / d
+	default:
+		diag[0], sup[0], slopes[0] = 2.0, 1.0, 3.0*delta[0]
+	}
+
+	for i := 1; i < n-1; i++ {
+		sub[i], diag[i], sup[i] = h[i], 2.0*(h[i-1]+h[i]), h[i-1]
+		slopes[i] = 3.0 * (h[i]*delta[i-1] + h[i-1]*delta[i])
+	}
+
+	switch j, k := n-3, n-2; right.kind {
+	case clampedBoundary:
+		diag[n-1], slopes[n-1] = 1.0, right.slope
+	case notAKnotBoundary:
+		d := h[j] + h[k]
+		sub[n-1], diag[n-1] = d, h[j]
+		slopes[n-1] = (h[k]*h[k]*delta[j] + (2.0*d+h[k])*h[j]*delta[k]) / d
+	default:
+		sub[n-1], diag[n-1], slopes[n-1] = 1.0, 2.0, 3.0*delta[k]
+	}
+
+	solveTridiagonal(sub, diag, sup, slopes)
+
+	return slopes, nil
+}
+
+// periodicSplineSlopes returns the first derivatives at the data points of the periodic cubic spline
+// going through the `xys`, whose first and last ordinates are equal.
+func periodicSplineSlopes(xys XYs) []float64 {
+	m := len(xys) - 1
+
+	h := make([]float64, m)
+	delta := make([]float64, m)
+	for i := range h {
+		h[i] = xys[i+1].X - xys[i].X
+		delta[i] = (xys[i+1].Y - xys[i].Y) / h[i]
+	}
+
+	sub := make([]float64, m)
+	diag := make([]float64, m)
+	sup := make([]float64, m)
+	slopes := make([]float64, m, m+1)
+	for i := range slopes {
+		prev := (i + m - 1) % m
+		sub[i], diag[i], sup[i] = h[i], 2.0*(h[prev]+h[i]), h[prev]
+		slopes[i] = 3.0 * (h[i]*delta[prev] + h[prev]*delta[i])
+	}
+
+	solveCyclicTridiagonal(sub, diag, sup, slopes)
+
+	return append(slopes, slopes[0])
+}
@@ -0,0 +1,236 @@
+package interpolator
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testCubicFunc is a cubic polynomial, with its first and second derivatives.
+func testCubicFunc(x float64) (float64, float64, float64) {
+	return x*x*x - 2.0*x*x + x + 1.0, 3.0*x*x - 4.0*x + 1.0, 6.0*x - 4.0
+}
+
+// testCubicXYs samples testCubicFunc on an irregular grid.
+var testCubicXYs = func() XYs {
+	xs := []float64{0.0, 0.4, 1.0, 1.7, 2.5, 3.0}
+	xys := make(XYs, len(xs))
+	for i, x := range xs {
+		y, _, _ := testCubicFunc(x)
+		xys[i] = XY{X: x, Y: y}
+	}
+
+	return xys
+}()
+
+func TestNewCubicSplineEmptyXYs(t *testing.T) {
+	_, err := NewCubicSpline(XYs{})
+	require.ErrorIs(t, err, ErrNotEnoughPoints)
+}
+
+func TestNewCubicSplineUnsortedXYs(t *testing.T) {
+	_, err := NewCubicSpline(XYs{
+		{
+			X: 1.0,
+			Y: 1.0,
+		},
+		{
+			X: 0.0,
+			Y: 1.0,
+		},
+	})
+	require.ErrorIs(t, err, ErrUnsorted)
+}
+
+func TestNewCubicSplineSinglePoint(t *testing.T) {
+	const tol = 1e-15
+
+	interpolator, err := NewCubicSpline(XYs{
+		{
+			X: 0.0,
+			Y: 1.0,
+		},
+	})
+	require.NoError(t, err)
+
+	assert.InDelta(t, 1.0, interpolator.Value(-1.0), tol)
+	assert.InDelta(t, 1.0, interpolator.Value(0.0), tol)
+	assert.InDelta(t, 1.0, interpolator.Value(1.0), tol)
+
+	assert.InDelta(t, 0.0, interpolator.Gradient(-1.0), tol)
+	assert.InDelta(t, 0.0, interpolator.Gradient(0.0), tol)
+	assert.InDelta(t, 0.0, interpolator.Gradient(1.0), tol)
+}
+
+func TestCubicSplineReproducesCubic(t *testing.T) {
+	tolerance := 1.0e-10
+	_, leftSlope, _ := testCubicFunc(0.0)
+	_, rightSlope, _ := testCubicFunc(3.0)
+
+	testCases := []struct {
+		name string
+		opts []Option
+	}{
+		{
+			"Clamped",
+			[]Option{WithLeftBoundary(BoundaryClamped(leftSlope)), WithRightBoundary(BoundaryClamped(rightSlope))},
+		},
+		{
+			"NotAKnot",
+			[]Option{WithBoundary(BoundaryNotAKnot())},
+		},
+		{
+			"ClampedNotAKnot",
+			[]Option{WithLeftBoundary(BoundaryClamped(leftSlope)), WithRightBoundary(BoundaryNotAKnot())},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			interpolator, err := NewCubicSpline(testCubicXYs, tc.opts...)
+			require.NoError(t, err)
+
+			for _, x := range []float64{-0.5, 0.0, 0.2, 0.4, 0.9, 1.3, 2.0, 2.9, 3.0, 3.5} {
+				y, dy, d2y := testCubicFunc(x)
+				assert.InDelta(t, y, interpolator.Value(x), tolerance, "x=%v", x)
+				assert.InDelta(t, dy, interpolator.Gradient(x), tolerance, "x=%v", x)
+				assert.InDelta(t, d2y, interpolator.SecondDerivative(x), tolerance, "x=%v", x)
+			}
+		})
+	}
+}
+
+func TestCubicSplineBoundary(t *testing.T) {
+	tolerance := 1.0e-10
+
+	natural, err := NewCubicSpline(testCubicXYs)
+	require.NoError(t, err)
+	assert.InDelta(t, 0.0, natural.SecondDerivative(0.0), tolerance)
+	assert.InDelta(t, 0.0, natural.SecondDerivative(3.0), tolerance)
+
+	mixed, err := NewCubicSpline(testCubicXYs, WithLeftBoundary(BoundaryClamped(-2.0)))
+	require.NoError(t, err)
+	assert.InDelta(t, -2.0, mixed.Gradient(0.0), tolerance)
+	assert.InDelta(t, 0.0, mixed.SecondDerivative(3.0), tolerance)
+
+	linear, err := NewCubicSpline(testLinearXYs)
+	require.NoError(t, err)
+	for _, x := range []float64{-1.0, 0.3, 0.7, 1.2, 1.6, 3.0} {
+		assert.InDelta(t, testLinearFunc(x), linear.Value(x), tolerance)
+		assert.InDelta(t, 0.0, linear.SecondDerivative(x), tolerance)
+	}
+}
+
+func TestCubicSplineContinuity(t *testing.T) {
+	const h = 1.0e-9
+
+	for name, opts := range map[string][]Option{
+		"Natural":  nil,
+		"Clamped":  {WithBoundary(BoundaryClamped(1.0))},
+		"NotAKnot": {WithBoundary(BoundaryNotAKnot())},
+	} {
+		opts := opts
+		t.Run(name, func(t *testing.T) {
+			interpolator, err := NewCubicSpline(testWaveXYs, opts...)
+			require.NoError(t, err)
+
+			for _, xy := range testWaveXYs[1 : len(testWaveXYs)-1] {
+				assert.InDelta(t, interpolator.Value(xy.X), interpolator.Value(xy.X-h), 1.0e-6)
+				assert.InDelta(t, interpolator.Gradient(xy.X), interpolator.Gradient(xy.X-h), 1.0e-6)
+				assert.InDelta(t, interpolator.SecondDerivative(xy.X), interpolator.SecondDerivative(xy.X-h), 1.0e-6)
+			}
+		})
+	}
+}
+
+func TestCubicSplinePeriodic(t *testing.T) {
+	xs := testGrid(0.0, 2.0*math.Pi, 17)
+	ys := make([]float64, len(xs))
+	for i, x := range xs {
+		ys[i] = math.Sin(x)
+	}
+	ys[len(ys)-1] = ys[0]
+	xys, err := NewXYs(xs, ys)
+	require.NoError(t, err)
+
+	interpolator, err := NewCubicSpline(xys, WithBoundary(BoundaryPeriodic()))
+	require.NoError(t, err)
+
+	assert.InDelta(t, interpolator.Gradient(0.0), interpolator.Gradient(2.0*math.Pi), 1.0e-10)
+	assert.InDelta(t, interpolator.SecondDerivative(0.0), interpolator.SecondDerivative(2.0*math.Pi), 1.0e-10)
+	for _, x := range testGrid(0.0, 2.0*math.Pi, 101) {
+		assert.InDelta(t, math.Sin(x), interpolator.Value(x), 1.0e-3)
+		assert.InDelta(t, math.Cos(x), interpolator.Gradient(x), 1.0e-2)
+	}
+
+	for _, small := range []XYs{{{X: 0.0, Y: 1.0}, {X: 1.0, Y: 1.0}}, {{X: 0.0, Y: 1.0}, {X: 1.0, Y: 2.0}, {X: 3.0, Y: 1.0}}} {
+		interpolator, err := NewCubicSpline(small, WithBoundary(BoundaryPeriodic()))
+		require.NoError(t, err)
+		assert.InDelta(t, interpolator.Gradient(0.0), interpolator.Gradient(small[len(small)-1].X), 1.0e-10)
+		assert.InDelta(t, interpolator.SecondDerivative(0.0), interpolator.SecondDerivative(small[len(small)-1].X), 1.0e-10)
+	}
+}
+
+func TestCubicSplinePeriodicErrors(t *testing.T) {
+	_, err := NewCubicSpline(testLinearXYs, WithBoundary(BoundaryPeriodic()))
+	require.ErrorIs(t, err, ErrNotPeriodic)
+
+	_, err = NewCubicSpline(testWaveXYs, WithLeftBoundary(BoundaryPeriodic()))
+	require.ErrorIs(t, err, ErrInvalidBoundary)
+}
+
+func TestCubicSplineNotAKnotFewPoints(t *testing.T) {
+	tolerance := 1.0e-12
+
+	line, err := NewCubicSpline(testLinearXYs[:2], WithBoundary(BoundaryNotAKnot()))
+	require.NoError(t, err)
+	assert.InDelta(t, testLinearFunc(0.3), line.Value(0.3), tolerance)
+
+	parabola, err := NewCubicSpline(XYs{{X: 0.0, Y: 0.0}, {X: 1.0, Y: 1.0}, {X: 3.0, Y: 9.0}}, WithBoundary(BoundaryNotAKnot()))
+	require.NoError(t, err)
+	for _, x := range []float64{-1.0, 0.5, 2.0, 4.0} {
+		assert.InDelta(t, x*x, parabola.Value(x), tolerance)
+	}
+}
+
+func ExampleCubicSpline_Value() {
+	xys := XYs{
+		{
+			X: 0.0,
+			Y: 1.2,
+		},
+		{
+			X: 0.5,
+			Y: 1.0,
+		},
+		{
+			X: 1.0,
+			Y: 1.4,
+		},
+	}
+	interp, err := NewCubicSpline(xys)
+	if err != nil {
+		return
+	}
+	fmt.Printf("%0.4f\n", interp.Value(0.75))
+	// Output: 1.1438
+}
+
+func BenchmarkCubicSplineValue(b *testing.B) {
+	interpolator, err := NewCubicSpline(testCubicXYs, WithBoundary(BoundaryNotAKnot()))
+	require.NoError(b, err)
+	var (
+		x       = 1.3
+		y, _, _ = testCubicFunc(x)
+		v       float64
+	)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		v = interpolator.Value(x)
+	}
+	b.StopTimer()
+	assert.InDelta(b, y, v, 1.0e-10)
+}
@@ -0,0 +1,89 @@
+package interpolator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuadraticRoots(t *testing.T) {
+	testCases := []struct {
+		name     string
+		a        float64
+		b        float64
+		c        float64
+		expected []float64
+	}{
+		{
+			"TwoRoots",
+			1.0,
+			-3.0,
+			2.0,
+			[]float64{1.0, 2.0},
+		},
+		{
+			"NegativeLeadingCoefficient",
+			-2.0,
+			0.0,
+			2.0,
+			[]float64{-1.0, 1.0},
+		},
+		{
+			"DoubleRoot",
+			1.0,
+			-2.0,
+			1.0,
+			[]float64{1.0},
+		},
+		{
+			"NoRoot",
+			1.0,
+			0.0,
+			1.0,
+			nil,
+		},
+		{
+			"Linear",
+			0.0,
+			2.0,
+			-1.0,
+			[]float64{0.5},
+		},
+		{
+			"Constant",
+			0.0,
+			0.0,
+			1.0,
+			nil,
+		},
+		{
+			"SmallRoot",
+			1.0,
+			-1.0e8,
+			1.0,
+			[]float64{1.0e-8, 1.0e8},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			roots := quadraticRoots(tc.a, tc.b, tc.c)
+			assert.Len(t, roots, len(tc.expected))
+			for i := range roots {
+				assert.InEpsilon(t, tc.expected[i], roots[i], 1.0e-12)
+			}
+		})
+	}
+}
+
+func TestCubicPieceCrossingsTangent(t *testing.T) {
+	// (x-1)^2 * (x-3) touches 0 at 1 and crosses it at 3.
+	p := cubicPiece{x0: 0.0, c0: -3.0, c1: 7.0, c2: -5.0, c3: 1.0}
+
+	crossings := p.crossings(0.0, 0.0, 4.0, p.value(0.0), p.value(4.0), nil)
+	assertCrossings(t, []Crossing{{From: 1.0, To: 1.0}, {From: 3.0, To: 3.0}}, crossings)
+
+	lo, hi := p.extrema(0.0, 4.0, p.value(0.0), p.value(4.0))
+	assert.Equal(t, XY{X: 0.0, Y: -3.0}, lo)
+	assert.Equal(t, XY{X: 4.0, Y: 9.0}, hi)
+}
@@ -0,0 +1,188 @@
+package interpolator
+
+import (
+	"fmt"
+	"math"
+)
+
+// curve holds the data shared by the piecewise interpolators:
+// the input points, the interpolation law of each segment
+// and the extrapolation laws on both sides of the domain.
+type curve struct {
+	xys    XYs
+	xs     []float64
+	search searcher
+	pieces []piece
+
+	// monotone is 1 for strictly increasing ordinates, -1 for strictly decreasing ones and 0 otherwise.
+	monotone int
+	extrema  *extremaTree
+
+	// end is the law evaluated at the last data point, if it differs from the law of the last segment, see flatEnd.
+	end piece
+
+	left        piece
+	right       piece
+	leftPolicy  Extrapolation
+	rightPolicy Extrapolation
+}
+
+// newCurve builds a curve from valid data points, using the `law` to interpolate
+// between consecutive points. The curve takes ownership of the `xys`.
+// In case a single data point is provided, the curve is constant.
+// Consecutive points sharing an abscissa denote a jump, see WithJumps.
+func newCurve(xys XYs, cfg *config, law func(p1, p2 XY) piece) (*curve, error) {
+	pieces := make([]piece, len(xys)-1)
+	for i := range pieces {
+		if xys[i].X == xys[i+1].X {
+			pieces[i] = jumpPiece(xys[i+1])
+
+			continue
+		}
+		pieces[i] = law(xys[i], xys[i+1])
+	}
+
+	return newCurveFromPieces(xys, cfg, pieces)
+}
+
+// newCurveFromPieces builds a curve from valid data points and the interpolation laws
+// between consecutive points. The curve takes ownership of the `xys` and the `pieces`.
+// In case a single data point is provided, the curve is constant.
+func newCurveFromPieces(xys XYs, cfg *config, pieces []piece) (*curve, error) {
+	n := len(xys)
+
+	xs := make([]float64, n)
+	for i, xy := range xys {
+		xs[i] = xy.X
+	}
+
+	search, err := newSearcher(cfg.search, xs)
+	if err != nil {
+		return nil, err
+	}
+
+	if n == 1 {
+		pieces = []piece{constantPiece(xys[0].Y)}
+	}
+
+	c := &curve{
+		xys:         xys,
+		xs:          xs,
+		search:      search,
+		pieces:      pieces,
+		monotone:    monotony(xys),
+		left:        cfg.left.piece(xys[0], pieces[0]),
+		right:       cfg.right.piece(xys[n-1], pieces[len(pieces)-1]),
+		leftPolicy:  cfg.left,
+		rightPolicy: cfg.right,
+	}
+	c.extrema = newExtremaTree(c)
+
+	return c, nil
+}
+
+// at returns the interpolation law which applies at x, which is undefined if x is NaN.
+func (c *curve) at(x float64) piece {
+	xMin, xMax := c.Domain()
+	switch {
+	case math.IsNaN(x):
+		return nanPiece{}
+	case x < xMin:
+		return c.left
+	case x > xMax:
+		return c.right
+	case x == xMax && c.end != nil:
+		return c.end
+	}
+
+	return c.pieces[c.index(x)]
+}
+
+// flatEnd sets the derivatives of the curve to 0 at the last data point, as at the first point
+// of the segments of the laws which are not differentiable there. The extrapolation still
+// follows the law of the last segment, so that ExtrapolateTangent uses its derivative from the left.
+func (c *curve) flatEnd() {
+	c.end = flatPiece{c.pieces[len(c.pieces)-1]}
+}
+
+// index returns the index of the segment containing x, which must lie inside the domain.
+func (c *curve) index(x float64) int {
+	return c.search.segment(x)
+}
+
+// walk calls `fn` on each of the sub-intervals [u, v] of [a, b], with a < b, which follow a single law:
+// the extrapolation regions, with index -1 on the left and len(c.pieces) on the right,
+// and the segments, with their index.
+func (c *curve) walk(a, b float64, fn func(k int, u, v float64, p piece)) {
+	xMin, xMax := c.Domain()
+	if a < xMin {
+		fn(-1, a, min(b, xMin), c.left)
+	}
+
+	if lo, hi := max(a, xMin), min(b, xMax); lo < hi {
+		for k, last := c.index(lo), c.index(hi); k <= last; k++ {
+			if u, v := max(lo, c.xs[k]), min(hi, c.xs[k+1]); u < v {
+				fn(k, u, v, c.pieces[k])
+			}
+		}
+	}
+
+	if b > xMax {
+		fn(len(c.pieces), max(a, xMax), b, c.right)
+	}
+}
+
+func (c *curve) value(x float64) float64 {
+	return c.at(x).value(x)
+}
+
+func (c *curve) gradient(x float64) float64 {
+	return c.at(x).gradient(x)
+}
+
+func (c *curve) secondDerivative(x float64) float64 {
+	return c.at(x).secondDerivative(x)
+}
+
+// valueLeft returns the limit of the curve at x from the left, which is its value except at the jumps,
+// where it is the ordinate of the first of the data points sharing the abscissa x.
+func (c *curve) valueLeft(x float64) float64 {
+	if i, ok := c.jumpAt(x); ok {
+		return c.xys[i-1].Y
+	}
+
+	return c.value(x)
+}
+
+// jumpAt returns the index of the second of the data points sharing the abscissa x,
+// whose ordinate is the value of the curve at x, and whether the curve jumps at x.
+func (c *curve) jumpAt(x float64) (int, bool) {
+	if xMin, xMax := c.Domain(); x > xMin && x <= xMax {
+		if i := c.index(x); i > 0 && c.xs[i] == x && c.xs[i-1] == x {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
+
+// Domain returns the smallest and largest abscissas of the input data.
+func (c *curve) Domain() (float64, float64) {
+	return c.xs[0], c.xs[len(c.xs)-1]
+}
+
+// Points returns a copy of the input data.
+func (c *curve) Points() XYs {
+	return c.xys.Copy()
+}
+
+// Check returns an error wrapping ErrOutOfDomain if x lies outside of the domain,
+// on a side configured with ExtrapolateError.
+func (c *curve) Check(x float64) error {
+	xMin, xMax := c.Domain()
+	if (x < xMin && c.leftPolicy.kind == errorExtrapolation) || (x > xMax && c.rightPolicy.kind == errorExtrapolation) {
+		return fmt.Errorf("%w: %v is not in [%v, %v]", ErrOutOfDomain, x, xMin, xMax)
+	}
+
+	return nil
+}
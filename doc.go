@@ -1,5 +1,8 @@
 /*
 Package interpolator provides interpolators to
 perform numerical interpolator for univariate data.
+
+Interpolators copy their input data at construction:
+they are immutable and can be shared between goroutines.
 */
 package interpolator
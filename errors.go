@@ -0,0 +1,41 @@
+package interpolator
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrNotEnoughPoints is returned when too few data points are provided to build an interpolator.
+	ErrNotEnoughPoints = errors.New("not enough points")
+	// ErrUnsorted is returned when the abscissas of the data points are not in increasing order.
+	ErrUnsorted = errors.New("abscissas are not sorted")
+	// ErrDuplicateAbscissa is returned when two data points share the same abscissa.
+	ErrDuplicateAbscissa = errors.New("duplicate abscissa")
+	// ErrNotFinite is returned when a data point has a NaN or infinite coordinate.
+	ErrNotFinite = errors.New("coordinate is not finite")
+	// ErrNonPositive is returned when a data point has an ordinate which is not strictly positive.
+	ErrNonPositive = errors.New("ordinate is not positive")
+	// ErrNotTransformable is returned when a data point is outside of the domain of a transform.
+	ErrNotTransformable = errors.New("coordinate is outside the domain of the transform")
+	// ErrNotConverged is returned when an approximation does not reach the required tolerance.
+	ErrNotConverged = errors.New("approximation did not converge")
+	// ErrLengthMismatch is returned when slices of abscissas and ordinates have different lengths.
+	ErrLengthMismatch = errors.New("length mismatch")
+)
+
+// PointError reports an invalid data point, identified by its index in the input data.
+type PointError struct {
+	Index int
+	Point XY
+	Err   error
+}
+
+func (e *PointError) Error() string {
+	return fmt.Sprintf("invalid point %d (%v, %v): %v", e.Index, e.Point.X, e.Point.Y, e.Err)
+}
+
+// Unwrap returns the underlying sentinel error.
+func (e *PointError) Unwrap() error {
+	return e.Err
+}
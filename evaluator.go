@@ -0,0 +1,131 @@
+package interpolator
+
+import "math"
+
+// Evaluator evaluates an interpolator at successive abscissas, remembering the segment
+// of the last evaluation: the segment of the next abscissa is hunted from there,
+// which takes near constant time for correlated abscissas, such as along a path or a time grid.
+//
+// The underlying interpolator is left untouched and can be shared, but an Evaluator
+// is not safe for concurrent use: each goroutine should use its own Evaluator.
+type Evaluator struct {
+	interp  Interpolator
+	curve   *curve
+	segment int
+}
+
+// NewEvaluator returns an Evaluator for the given interpolator.
+// Interpolators which are not piecewise are evaluated directly.
+func NewEvaluator(interp Interpolator) *Evaluator {
+	e := &Evaluator{
+		interp: interp,
+	}
+	if c, ok := interp.(interface{ base() *curve }); ok {
+		e.curve = c.base()
+	}
+
+	return e
+}
+
+// Value computes the value of f(x).
+func (e *Evaluator) Value(x float64) float64 {
+	if e.curve == nil {
+		return e.interp.Value(x)
+	}
+
+	return e.at(x).value(x)
+}
+
+// Gradient computes the gradient of f(x).
+func (e *Evaluator) Gradient(x float64) float64 {
+	if e.curve == nil {
+		return e.interp.Gradient(x)
+	}
+
+	return e.at(x).gradient(x)
+}
+
+// SecondDerivative computes the second derivative of f(x),
+// or NaN if the interpolator is not TwiceDifferentiable.
+func (e *Evaluator) SecondDerivative(x float64) float64 {
+	if e.curve == nil {
+		if interp, ok := e.interp.(TwiceDifferentiable); ok {
+			return interp.SecondDerivative(x)
+		}
+
+		return math.NaN()
+	}
+
+	return e.at(x).secondDerivative(x)
+}
+
+func (e *Evaluator) at(x float64) piece {
+	xMin, xMax := e.curve.Domain()
+	switch {
+	case math.IsNaN(x):
+		return nanPiece{}
+	case x < xMin:
+		return e.curve.left
+	case x > xMax:
+		return e.curve.right
+	case x == xMax && e.curve.end != nil:
+		return e.curve.end
+	}
+
+	e.segment = e.curve.hunt(x, e.segment)
+
+	return e.curve.pieces[e.segment]
+}
+
+// base returns the curve itself, to give access to the internals of the piecewise interpolators.
+func (c *curve) base() *curve {
+	return c
+}
+
+// hunt returns the index of the segment containing x, which must lie inside the domain,
+// starting the search from the segment `guess`.
+// The search brackets x with increasing steps away from the guess, then bisects the bracket.
+func (c *curve) hunt(x float64, guess int) int {
+	n := len(c.xs)
+	last := len(c.pieces) - 1
+	if guess < 0 || guess > last {
+		return c.index(x)
+	}
+
+	// Find lo < hi such that xs[lo] <= x < xs[hi], with hi == n standing for +Inf.
+	var lo, hi int
+	if c.xs[guess] <= x {
+		lo, hi = guess, guess+1
+		for step := 1; hi < n && c.xs[hi] <= x; step *= 2 {
+			lo = hi
+			hi = lo + step
+		}
+		if hi > n {
+			hi = n
+		}
+	} else {
+		lo, hi = guess-1, guess
+		for step := 1; lo > 0 && c.xs[lo] > x; step *= 2 {
+			hi = lo
+			lo = hi - step
+		}
+		if lo < 0 {
+			lo = 0
+		}
+	}
+
+	for hi-lo > 1 {
+		mid := lo + (hi-lo)/2
+		if c.xs[mid] <= x {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+
+	if lo > last {
+		return last
+	}
+
+	return lo
+}
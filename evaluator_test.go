@@ -0,0 +1,110 @@
+package interpolator
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testFunc struct{}
+
+func (testFunc) Value(x float64) float64 {
+	return math.Sin(x)
+}
+
+func (testFunc) Gradient(x float64) float64 {
+	return math.Cos(x)
+}
+
+func TestEvaluator(t *testing.T) {
+	xs := testGrid(0.0, 10.0, 50)
+	ys := make([]float64, len(xs))
+	for i, x := range xs {
+		ys[i] = math.Exp(math.Sin(x))
+	}
+	xys, err := NewXYs(xs, ys)
+	require.NoError(t, err)
+
+	rnd := rand.New(rand.NewSource(1))
+	walk := make([]float64, 1000)
+	for i := 1; i < len(walk); i++ {
+		walk[i] = walk[i-1] + rnd.NormFloat64()*0.5
+	}
+	queries := append(walk, xs...)
+	queries = append(queries, 10.0, 0.0, -1.0, 10.0, 11.0, 5.0, 0.0)
+
+	for _, method := range testBuiltinMethods {
+		method := method
+		t.Run(method, func(t *testing.T) {
+			interp, err := testNew(method, xys)
+			require.NoError(t, err)
+
+			evaluator := NewEvaluator(interp)
+			for _, x := range queries {
+				assert.InDelta(t, interp.Value(x), evaluator.Value(x), 1.0e-15)
+				assert.InDelta(t, interp.Gradient(x), evaluator.Gradient(x), 1.0e-15)
+			}
+		})
+	}
+}
+
+func TestEvaluatorSinglePoint(t *testing.T) {
+	interpolator, err := NewPiecewiseLinear(XYs{{X: 1.0, Y: 2.0}})
+	require.NoError(t, err)
+
+	evaluator := NewEvaluator(interpolator)
+	for _, x := range []float64{0.0, 1.0, 2.0, 1.0} {
+		assert.InDelta(t, 2.0, evaluator.Value(x), 1.0e-15)
+		assert.InDelta(t, 0.0, evaluator.Gradient(x), 1.0e-15)
+	}
+}
+
+func TestEvaluatorNaN(t *testing.T) {
+	interpolator, err := NewPiecewiseLinear(testLinearXYs)
+	require.NoError(t, err)
+
+	// A NaN abscissa does not move the evaluator away from its last segment.
+	evaluator := NewEvaluator(interpolator)
+	for _, x := range []float64{0.5, math.NaN(), 0.7} {
+		if math.IsNaN(x) {
+			assert.True(t, math.IsNaN(evaluator.Value(x)))
+			assert.True(t, math.IsNaN(evaluator.Gradient(x)))
+			assert.True(t, math.IsNaN(evaluator.SecondDerivative(x)))
+
+			continue
+		}
+		assert.InDelta(t, interpolator.Value(x), evaluator.Value(x), 1.0e-15)
+	}
+}
+
+func TestEvaluatorNotPiecewise(t *testing.T) {
+	evaluator := NewEvaluator(testFunc{})
+
+	assert.InDelta(t, math.Sin(0.5), evaluator.Value(0.5), 1.0e-15)
+	assert.InDelta(t, math.Cos(0.5), evaluator.Gradient(0.5), 1.0e-15)
+}
+
+func BenchmarkPiecewiseLinearValueWalk(b *testing.B) {
+	interpolator := benchmarkCurve(b)
+	xs := testGrid(0.0, 10.0, 10000)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for _, x := range xs {
+			interpolator.Value(x)
+		}
+	}
+}
+
+func BenchmarkEvaluatorValueWalk(b *testing.B) {
+	evaluator := NewEvaluator(benchmarkCurve(b))
+	xs := testGrid(0.0, 10.0, 10000)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for _, x := range xs {
+			evaluator.Value(x)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package interpolator
+
+import "errors"
+
+// ErrOutOfDomain is returned when evaluating an interpolator outside of its domain,
+// on a side configured with ExtrapolateError.
+var ErrOutOfDomain = errors.New("abscissa is out of domain")
+
+type extrapolationKind int
+
+const (
+	flatExtrapolation extrapolationKind = iota
+	edgeExtrapolation
+	linearExtrapolation
+	constantExtrapolation
+	nanExtrapolation
+	errorExtrapolation
+	tangentExtrapolation
+)
+
+// Extrapolation defines the behaviour of an interpolator outside of the domain of its input data.
+// The zero value is a flat extrapolation.
+type Extrapolation struct {
+	kind  extrapolationKind
+	param float64
+}
+
+// ExtrapolateFlat extrapolates with the ordinate of the closest data point.
+func ExtrapolateFlat() Extrapolation {
+	return Extrapolation{kind: flatExtrapolation}
+}
+
+// ExtrapolateEdge extrapolates by extending the interpolation law of the closest segment.
+// Laws which are not defined beyond their segment, such as the square root laws on the left side,
+// yield NaN.
+func ExtrapolateEdge() Extrapolation {
+	return Extrapolation{kind: edgeExtrapolation}
+}
+
+// ExtrapolateLinear extrapolates with a straight line of the given slope
+// going through the closest data point.
+func ExtrapolateLinear(slope float64) Extrapolation {
+	return Extrapolation{kind: linearExtrapolation, param: slope}
+}
+
+// ExtrapolateTangent extrapolates with the tangent to the closest segment at the closest data point.
+func ExtrapolateTangent() Extrapolation {
+	return Extrapolation{kind: tangentExtrapolation}
+}
+
+// ExtrapolateConstant extrapolates with the given constant value.
+func ExtrapolateConstant(value float64) Extrapolation {
+	return Extrapolation{kind: constantExtrapolation, param: value}
+}
+
+// ExtrapolateNaN returns NaN outside of the domain.
+func ExtrapolateNaN() Extrapolation {
+	return Extrapolation{kind: nanExtrapolation}
+}
+
+// ExtrapolateError returns NaN outside of the domain, and makes Check return ErrOutOfDomain.
+func ExtrapolateError() Extrapolation {
+	return Extrapolation{kind: errorExtrapolation}
+}
+
+// piece returns the interpolation law of the extrapolation region
+// beyond the `edge` data point, whose closest segment follows the `segment` law.
+func (e Extrapolation) piece(edge XY, segment piece) piece {
+	switch e.kind {
+	case edgeExtrapolation:
+		return segment
+	case linearExtrapolation:
+		return linearPiece{
+			x0:    edge.X,
+			y0:    edge.Y,
+			slope: e.param,
+		}
+	case tangentExtrapolation:
+		return linearPiece{
+			x0:    edge.X,
+			y0:    edge.Y,
+			slope: segment.gradient(edge.X),
+		}
+	case constantExtrapolation:
+		return constantPiece(e.param)
+	case nanExtrapolation, errorExtrapolation:
+		return nanPiece{}
+	default:
+		return constantPiece(edge.Y)
+	}
+}
@@ -0,0 +1,172 @@
+package interpolator
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtrapolation(t *testing.T) {
+	tolerance := 1.0e-8
+
+	testCases := []struct {
+		name          string
+		extrapolation Extrapolation
+		left          float64
+		leftGradient  float64
+		right         float64
+		rightGradient float64
+	}{
+		{
+			"Flat",
+			ExtrapolateFlat(),
+			testLinearFunc(0.0),
+			0.0,
+			testLinearFunc(2.0),
+			0.0,
+		},
+		{
+			"Edge",
+			ExtrapolateEdge(),
+			testLinearFunc(-1.0),
+			1.2,
+			testLinearFunc(3.0),
+			1.2,
+		},
+		{
+			"Linear",
+			ExtrapolateLinear(2.0),
+			testLinearFunc(0.0) - 2.0,
+			2.0,
+			testLinearFunc(2.0) + 2.0,
+			2.0,
+		},
+		{
+			"Tangent",
+			ExtrapolateTangent(),
+			testLinearFunc(-1.0),
+			1.2,
+			testLinearFunc(3.0),
+			1.2,
+		},
+		{
+			"Constant",
+			ExtrapolateConstant(-3.0),
+			-3.0,
+			0.0,
+			-3.0,
+			0.0,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			interpolator, err := NewPiecewiseLinearThreshold(testLinearXYs, WithExtrapolation(tc.extrapolation))
+			require.NoError(t, err)
+
+			assert.InDelta(t, tc.left, interpolator.Value(-1.0), tolerance)
+			assert.InDelta(t, tc.leftGradient, interpolator.Gradient(-1.0), tolerance)
+			assert.InDelta(t, tc.right, interpolator.Value(3.0), tolerance)
+			assert.InDelta(t, tc.rightGradient, interpolator.Gradient(3.0), tolerance)
+
+			assert.InDelta(t, testLinearFunc(0.7), interpolator.Value(0.7), tolerance)
+			require.NoError(t, interpolator.Check(-1.0))
+		})
+	}
+}
+
+func TestExtrapolationNaN(t *testing.T) {
+	interpolator, err := NewGeometric(testExpXYs, WithExtrapolation(ExtrapolateNaN()))
+	require.NoError(t, err)
+
+	assert.True(t, math.IsNaN(interpolator.Value(-1.0)))
+	assert.True(t, math.IsNaN(interpolator.Gradient(3.0)))
+	assert.InEpsilon(t, math.Exp(2.0), interpolator.Value(2.0), 1.0e-12)
+}
+
+func TestExtrapolationNaNAbscissa(t *testing.T) {
+	// A NaN abscissa is neither inside the domain nor extrapolated.
+	for _, extrapolation := range []Extrapolation{ExtrapolateFlat(), ExtrapolateEdge(), ExtrapolateConstant(1.0)} {
+		interpolator, err := NewPiecewiseLinear(testLinearXYs, WithExtrapolation(extrapolation))
+		require.NoError(t, err)
+
+		assert.True(t, math.IsNaN(interpolator.Value(math.NaN())))
+		assert.True(t, math.IsNaN(interpolator.Gradient(math.NaN())))
+	}
+}
+
+func TestExtrapolationError(t *testing.T) {
+	interpolator, err := NewPiecewiseConstant(
+		testLinearXYs,
+		WithLeftExtrapolation(ExtrapolateError()),
+		WithRightExtrapolation(ExtrapolateLinear(1.0)),
+	)
+	require.NoError(t, err)
+
+	assert.True(t, math.IsNaN(interpolator.Value(-1.0)))
+	require.ErrorIs(t, interpolator.Check(-1.0), ErrOutOfDomain)
+
+	require.NoError(t, interpolator.Check(0.0))
+	require.NoError(t, interpolator.Check(3.0))
+	assert.InDelta(t, testLinearFunc(2.0)+1.0, interpolator.Value(3.0), 1.0e-12)
+	assert.InDelta(t, 1.0, interpolator.Gradient(3.0), 1.0e-12)
+}
+
+func TestExtrapolationSinglePoint(t *testing.T) {
+	xys := XYs{
+		{
+			X: 1.0,
+			Y: 2.0,
+		},
+	}
+
+	interpolator, err := NewGeometricSqrt(xys, WithExtrapolation(ExtrapolateEdge()))
+	require.NoError(t, err)
+	assert.InDelta(t, 2.0, interpolator.Value(0.0), 1.0e-15)
+	assert.InDelta(t, 2.0, interpolator.Value(3.0), 1.0e-15)
+
+	interpolator, err = NewGeometricSqrt(xys, WithExtrapolation(ExtrapolateLinear(0.5)))
+	require.NoError(t, err)
+	assert.InDelta(t, 1.5, interpolator.Value(0.0), 1.0e-15)
+	assert.InDelta(t, 2.0, interpolator.Value(1.0), 1.0e-15)
+	assert.InDelta(t, 3.0, interpolator.Value(3.0), 1.0e-15)
+}
+
+func TestExtrapolationEdgeSqrt(t *testing.T) {
+	interpolator, err := NewPiecewiseLinearSqrt(testLinearXYs, WithExtrapolation(ExtrapolateEdge()))
+	require.NoError(t, err)
+
+	assert.True(t, math.IsNaN(interpolator.Value(-1.0)))
+	assert.InDelta(t, testLinearFunc(1.5)+0.6*math.Sqrt(2.0), interpolator.Value(2.5), 1.0e-12)
+}
+
+func TestExtrapolationTangent(t *testing.T) {
+	interpolator, err := NewGeometric(testExpXYs, WithExtrapolation(ExtrapolateTangent()))
+	require.NoError(t, err)
+
+	assert.InDelta(t, 0.0, interpolator.Value(-1.0), 1.0e-12)
+	assert.InEpsilon(t, 1.0, interpolator.Gradient(-1.0), 1.0e-12)
+	assert.InEpsilon(t, math.Exp(2.0)*2.0, interpolator.Value(3.0), 1.0e-12)
+	assert.InEpsilon(t, math.Exp(2.0), interpolator.Gradient(3.0), 1.0e-12)
+}
+
+func TestExtrapolationTangentSqrt(t *testing.T) {
+	xys := XYs{{X: 0.0, Y: 1.0}, {X: 1.0, Y: 2.0}}
+
+	for method, slope := range map[string]float64{MethodPiecewiseLinearSqrt: 0.5, MethodGeometricSqrt: math.Ln2} {
+		method, slope := method, slope
+		t.Run(method, func(t *testing.T) {
+			interp, err := New(method, xys, WithRightExtrapolation(ExtrapolateTangent()))
+			require.NoError(t, err)
+
+			// The tangent follows the last segment from the left, while the derivatives are 0 at the data points.
+			assert.InDelta(t, 2.0+slope, interp.Value(2.0), 1.0e-12)
+			assert.InDelta(t, slope, interp.Gradient(2.0), 1.0e-12)
+			assert.Zero(t, interp.Gradient(1.0))
+			assert.Zero(t, interp.(TwiceDifferentiable).SecondDerivative(1.0))
+			assert.Zero(t, interp.(TwiceDifferentiable).SecondDerivative(2.0))
+		})
+	}
+}
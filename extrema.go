@@ -0,0 +1,151 @@
+package interpolator
+
+import "math"
+
+// Min returns the point where f reaches its minimum on [a, b], including the extrapolation regions.
+// In case of ties, the point with the smallest abscissa is returned. See Range for the jumps.
+func (c *curve) Min(a, b float64) XY {
+	lo, _ := c.Range(a, b)
+
+	return lo
+}
+
+// Max returns the point where f reaches its maximum on [a, b], including the extrapolation regions.
+// In case of ties, the point with the smallest abscissa is returned. See Range for the jumps.
+func (c *curve) Max(a, b float64) XY {
+	_, hi := c.Range(a, b)
+
+	return hi
+}
+
+// Range returns the points where f reaches its minimum and maximum on [a, b],
+// including the extrapolation regions. The extrema are computed exactly from the
+// bounds and stationary points of each segment, and the segments fully included in [a, b]
+// are handled in O(log n) using extrema precomputed at construction.
+// NaN values are ignored.
+// At a jump, see WithJumps, the limit of f from the left is considered as reached at the abscissa
+// of the jump, see ValueLeft: the extrema are then the infimum and supremum of f on [a, b],
+// which may only be approached before the jump.
+// If a or b is NaN, both points are {NaN, NaN}.
+func (c *curve) Range(a, b float64) (XY, XY) {
+	if math.IsNaN(a) || math.IsNaN(b) {
+		nan := XY{X: math.NaN(), Y: math.NaN()}
+
+		return nan, nan
+	}
+	if a > b {
+		a, b = b, a
+	}
+
+	lo, hi := emptyRange()
+	if a == b {
+		xy := XY{X: a, Y: c.value(a)}
+
+		return xy, xy
+	}
+
+	update := func(k int, u, v float64, p piece) {
+		pLo, pHi := p.extrema(u, v, c.bound(k, u, p), c.bound(k, v, p))
+		lo, hi = lower(lo, pLo), higher(hi, pHi)
+	}
+
+	xMin, xMax := c.Domain()
+	if a < xMin {
+		update(-1, a, min(b, xMin), c.left)
+	}
+	if b > xMax {
+		update(len(c.pieces), max(a, xMax), b, c.right)
+	}
+
+	if l, h := max(a, xMin), min(b, xMax); l < h {
+		i, j := c.index(l), c.index(h)
+		if h == c.xs[j] {
+			j--
+		}
+
+		if i == j {
+			update(i, l, h, c.pieces[i])
+		} else {
+			update(i, l, c.xs[i+1], c.pieces[i])
+			tLo, tHi := c.extrema.query(i+1, j)
+			lo, hi = lower(lo, tLo), higher(hi, tHi)
+			update(j, c.xs[j], h, c.pieces[j])
+		}
+	}
+
+	return lo, hi
+}
+
+// emptyRange returns the neutral elements of lower and higher.
+func emptyRange() (XY, XY) {
+	return XY{X: math.NaN(), Y: math.Inf(1)}, XY{X: math.NaN(), Y: math.Inf(-1)}
+}
+
+// lower returns the point with the lowest ordinate, or the smallest abscissa in case of ties.
+func lower(p, q XY) XY {
+	if q.Y < p.Y || (q.Y == p.Y && q.X < p.X) {
+		return q
+	}
+
+	return p
+}
+
+// higher returns the point with the highest ordinate, or the smallest abscissa in case of ties.
+func higher(p, q XY) XY {
+	if q.Y > p.Y || (q.Y == p.Y && q.X < p.X) {
+		return q
+	}
+
+	return p
+}
+
+// monotoneExtrema returns the extrema of a law which is monotone on [u, v],
+// with values fu and fv at the bounds.
+func monotoneExtrema(u, v, fu, fv float64) (XY, XY) {
+	pu, pv := XY{X: u, Y: fu}, XY{X: v, Y: fv}
+
+	return lower(pu, pv), higher(pu, pv)
+}
+
+// extremaTree is a segment tree holding the extrema of the segments of a curve,
+// to find the extrema over a range of segments in O(log n).
+type extremaTree struct {
+	n    int
+	mins []XY
+	maxs []XY
+}
+
+func newExtremaTree(c *curve) *extremaTree {
+	n := len(c.xs) - 1
+	t := &extremaTree{
+		n:    n,
+		mins: make([]XY, 2*n),
+		maxs: make([]XY, 2*n),
+	}
+	for k := 0; k < n; k++ {
+		t.mins[n+k], t.maxs[n+k] = c.pieces[k].extrema(c.xs[k], c.xs[k+1], c.xys[k].Y, c.xys[k+1].Y)
+	}
+	for k := n - 1; k > 0; k-- {
+		t.mins[k] = lower(t.mins[2*k], t.mins[2*k+1])
+		t.maxs[k] = higher(t.maxs[2*k], t.maxs[2*k+1])
+	}
+
+	return t
+}
+
+// query returns the extrema of the segments of indices in [i, j).
+func (t *extremaTree) query(i, j int) (XY, XY) {
+	lo, hi := emptyRange()
+	for i, j = i+t.n, j+t.n; i < j; i, j = i/2, j/2 {
+		if i%2 == 1 {
+			lo, hi = lower(lo, t.mins[i]), higher(hi, t.maxs[i])
+			i++
+		}
+		if j%2 == 1 {
+			j--
+			lo, hi = lower(lo, t.mins[j]), higher(hi, t.maxs[j])
+		}
+	}
+
+	return lo, hi
+}
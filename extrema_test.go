@@ -0,0 +1,250 @@
+package interpolator
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPiecewiseLinearRange(t *testing.T) {
+	interpolator, err := NewPiecewiseLinear(testWaveXYs)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name string
+		a    float64
+		b    float64
+		min  XY
+		max  XY
+	}{
+		{
+			"Domain",
+			0.0,
+			3.0,
+			XY{X: 1.0, Y: -1.0},
+			XY{X: 3.0, Y: 2.0},
+		},
+		{
+			"Extrapolation",
+			-1.0,
+			4.0,
+			XY{X: 1.0, Y: -1.0},
+			XY{X: 4.0, Y: 5.0},
+		},
+		{
+			"Segment",
+			0.25,
+			0.75,
+			XY{X: 0.75, Y: -0.5},
+			XY{X: 0.25, Y: 0.5},
+		},
+		{
+			"Plateau",
+			1.5,
+			2.0,
+			XY{X: 1.5, Y: -1.0},
+			XY{X: 1.5, Y: -1.0},
+		},
+		{
+			"Point",
+			0.5,
+			0.5,
+			XY{X: 0.5, Y: 0.0},
+			XY{X: 0.5, Y: 0.0},
+		},
+		{
+			"ReversedBounds",
+			3.0,
+			0.5,
+			XY{X: 1.0, Y: -1.0},
+			XY{X: 3.0, Y: 2.0},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			lo, hi := interpolator.Range(tc.a, tc.b)
+			assert.InDelta(t, tc.min.X, lo.X, 1.0e-12)
+			assert.InDelta(t, tc.min.Y, lo.Y, 1.0e-12)
+			assert.InDelta(t, tc.max.X, hi.X, 1.0e-12)
+			assert.InDelta(t, tc.max.Y, hi.Y, 1.0e-12)
+
+			assert.Equal(t, lo, interpolator.Min(tc.a, tc.b))
+			assert.Equal(t, hi, interpolator.Max(tc.a, tc.b))
+		})
+	}
+}
+
+func TestRangeNaN(t *testing.T) {
+	interpolator, err := NewPiecewiseLinear(testLinearXYs)
+	require.NoError(t, err)
+
+	assertNaN := func(xy XY) {
+		t.Helper()
+		assert.True(t, math.IsNaN(xy.X), "%v", xy)
+		assert.True(t, math.IsNaN(xy.Y), "%v", xy)
+	}
+	for _, bounds := range [][2]float64{{math.NaN(), 1.0}, {0.0, math.NaN()}, {math.NaN(), math.NaN()}} {
+		lo, hi := interpolator.Range(bounds[0], bounds[1])
+		assertNaN(lo)
+		assertNaN(hi)
+		assertNaN(interpolator.Min(bounds[0], bounds[1]))
+		assertNaN(interpolator.Max(bounds[0], bounds[1]))
+	}
+}
+
+func TestPiecewiseConstantRange(t *testing.T) {
+	interpolator, err := NewPiecewiseConstant(XYs{{X: 0.0, Y: 1.0}, {X: 1.0, Y: 3.0}, {X: 2.0, Y: 2.0}})
+	require.NoError(t, err)
+
+	lo, hi := interpolator.Range(0.5, 1.5)
+	assert.Equal(t, XY{X: 0.5, Y: 1.0}, lo)
+	assert.Equal(t, XY{X: 1.0, Y: 3.0}, hi)
+
+	lo, hi = interpolator.Range(1.5, 3.0)
+	assert.Equal(t, XY{X: 2.0, Y: 2.0}, lo)
+	assert.Equal(t, XY{X: 1.5, Y: 3.0}, hi)
+}
+
+func TestPiecewiseConstantLeftRange(t *testing.T) {
+	interpolator, err := NewPiecewiseConstant(XYs{{X: 0.0, Y: 1.0}, {X: 1.0, Y: 3.0}, {X: 2.0, Y: 2.0}}, WithStep(StepLeft))
+	require.NoError(t, err)
+
+	lo, hi := interpolator.Range(0.5, 1.5)
+	assert.Equal(t, XY{X: 1.5, Y: 2.0}, lo)
+	assert.Equal(t, XY{X: 0.5, Y: 3.0}, hi)
+
+	lo, hi = interpolator.Range(-1.0, 0.0)
+	assert.Equal(t, XY{X: -1.0, Y: 1.0}, lo)
+	assert.Equal(t, XY{X: -1.0, Y: 1.0}, hi)
+}
+
+func TestPiecewiseConstantNearestRange(t *testing.T) {
+	interpolator, err := NewPiecewiseConstant(XYs{{X: 1.0, Y: 1.0}, {X: 3.0, Y: 4.0}, {X: 5.0, Y: 2.0}}, WithStep(StepNearest))
+	require.NoError(t, err)
+
+	// The curve only takes the ordinate of the next point after the midpoint.
+	for _, bounds := range [][2]float64{{0.0, 5.0}, {1.0, 2.5}, {1.5, 4.5}, {2.0, 3.0}, {3.5, 5.0}} {
+		lo, hi := interpolator.Range(bounds[0], bounds[1])
+		for _, xy := range []XY{lo, hi} {
+			assert.GreaterOrEqual(t, xy.X, bounds[0])
+			assert.LessOrEqual(t, xy.X, bounds[1])
+			assert.Equal(t, interpolator.Value(xy.X), xy.Y, "%v on %v", xy, bounds)
+		}
+	}
+
+	assert.Equal(t, XY{X: 3.0, Y: 4.0}, interpolator.Max(0.0, 5.0))
+	assert.Equal(t, XY{X: 2.5, Y: 4.0}, interpolator.Max(1.0, 2.5))
+	assert.Equal(t, XY{X: 2.0, Y: 1.0}, interpolator.Min(2.0, 3.0))
+}
+
+func TestPiecewiseLinearJumpsRange(t *testing.T) {
+	// The curve jumps down from 2 to 0 at 1, so that its supremum is only approached before the jump.
+	interpolator, err := NewPiecewiseLinear(XYs{{X: 0.0, Y: 0.0}, {X: 1.0, Y: 2.0}, {X: 1.0, Y: 0.0}, {X: 2.0, Y: 1.0}}, WithJumps())
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name string
+		a    float64
+		b    float64
+		min  XY
+		max  XY
+	}{
+		{
+			"Domain",
+			0.0,
+			2.0,
+			XY{X: 0.0, Y: 0.0},
+			XY{X: 1.0, Y: 2.0},
+		},
+		{
+			"EndAtJump",
+			0.5,
+			1.0,
+			XY{X: 1.0, Y: 0.0},
+			XY{X: 1.0, Y: 2.0},
+		},
+		{
+			"StartAtJump",
+			1.0,
+			2.0,
+			XY{X: 1.0, Y: 0.0},
+			XY{X: 2.0, Y: 1.0},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			lo, hi := interpolator.Range(tc.a, tc.b)
+			assert.Equal(t, tc.min, lo)
+			assert.Equal(t, tc.max, hi)
+		})
+	}
+
+	// The supremum is the limit from the left at the jump, which is not reached.
+	assert.Equal(t, 2.0, interpolator.ValueLeft(1.0))
+	assert.Equal(t, 0.0, interpolator.Value(1.0))
+	assert.Empty(t, interpolator.Crossings(2.0, 0.0, 2.0))
+}
+
+func TestRange(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	xs := testGrid(0.0, 10.0, 101)
+	ys := make([]float64, len(xs))
+	for i := range ys {
+		ys[i] = math.Exp(rnd.NormFloat64())
+	}
+	xys, err := NewXYs(xs, ys)
+	require.NoError(t, err)
+
+	for _, method := range testBuiltinMethods {
+		method := method
+		t.Run(method, func(t *testing.T) {
+			interp, err := testNew(method, xys, WithLeftExtrapolation(ExtrapolateLinear(1.0)))
+			require.NoError(t, err)
+
+			ranger, ok := interp.(Ranger)
+			require.True(t, ok)
+
+			for i := 0; i < 50; i++ {
+				a, b := -1.0+12.0*rnd.Float64(), -1.0+12.0*rnd.Float64()
+				lo, hi := ranger.Range(a, b)
+
+				assert.InDelta(t, interp.Value(lo.X), lo.Y, 1.0e-12)
+				assert.InDelta(t, interp.Value(hi.X), hi.Y, 1.0e-12)
+				for _, x := range testGrid(math.Min(a, b), math.Max(a, b), 1001) {
+					y := interp.Value(x)
+					assert.LessOrEqual(t, lo.Y, y+1.0e-12)
+					assert.GreaterOrEqual(t, hi.Y, y-1.0e-12)
+				}
+			}
+		})
+	}
+}
+
+func ExamplePiecewiseLinear_Range() {
+	xys := XYs{
+		{
+			X: 0.0,
+			Y: 1.0,
+		},
+		{
+			X: 1.0,
+			Y: -1.0,
+		},
+		{
+			X: 2.0,
+			Y: 0.5,
+		},
+	}
+	interp, err := NewPiecewiseLinear(xys)
+	if err != nil {
+		return
+	}
+	fmt.Println(interp.Range(0.5, 3.0))
+	// Output: {1 -1} {3 2}
+}
@@ -0,0 +1,131 @@
+package interpolator
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Names of the built-in interpolation methods, see New.
+const (
+	MethodPiecewiseConstant        = "constant"
+	MethodPiecewiseLinear          = "linear"
+	MethodPiecewiseLinearThreshold = "linear_threshold"
+	MethodPiecewiseLinearSqrt      = "linear_sqrt"
+	MethodGeometric                = "geometric"
+	MethodGeometricSqrt            = "geometric_sqrt"
+	MethodCubicSpline              = "cubic_spline"
+	MethodMonotoneCubic            = "monotone_cubic"
+	MethodAkima                    = "akima"
+	MethodMakima                   = "makima"
+	MethodSmoothingSpline          = "smoothing_spline"
+	MethodMonotoneConvex           = "monotone_convex"
+	MethodPolynomial               = "polynomial"
+	MethodBarycentricRational      = "barycentric_rational"
+)
+
+var (
+	// ErrUnknownMethod is returned when building an interpolator from an unregistered method name.
+	ErrUnknownMethod = errors.New("unknown interpolation method")
+	// ErrMethodRegistered is returned when registering a method name which is already in use.
+	ErrMethodRegistered = errors.New("interpolation method already registered")
+)
+
+// Constructor builds an interpolator from data points.
+type Constructor func(xys XYs, opts ...Option) (Interpolator, error)
+
+var registry = struct {
+	sync.RWMutex
+	constructors map[string]Constructor
+}{
+	constructors: map[string]Constructor{
+		MethodPiecewiseConstant: func(xys XYs, opts ...Option) (Interpolator, error) {
+			return NewPiecewiseConstant(xys, opts...)
+		},
+		MethodPiecewiseLinear: func(xys XYs, opts ...Option) (Interpolator, error) {
+			return NewPiecewiseLinear(xys, opts...)
+		},
+		MethodPiecewiseLinearThreshold: func(xys XYs, opts ...Option) (Interpolator, error) {
+			return NewPiecewiseLinearThreshold(xys, opts...)
+		},
+		MethodPiecewiseLinearSqrt: func(xys XYs, opts ...Option) (Interpolator, error) {
+			return NewPiecewiseLinearSqrt(xys, opts...)
+		},
+		MethodGeometric: func(xys XYs, opts ...Option) (Interpolator, error) {
+			return NewGeometric(xys, opts...)
+		},
+		MethodGeometricSqrt: func(xys XYs, opts ...Option) (Interpolator, error) {
+			return NewGeometricSqrt(xys, opts...)
+		},
+		MethodCubicSpline: func(xys XYs, opts ...Option) (Interpolator, error) {
+			return NewCubicSpline(xys, opts...)
+		},
+		MethodMonotoneCubic: func(xys XYs, opts ...Option) (Interpolator, error) {
+			return NewMonotoneCubic(xys, opts...)
+		},
+		MethodAkima: func(xys XYs, opts ...Option) (Interpolator, error) {
+			return NewAkima(xys, opts...)
+		},
+		MethodMakima: func(xys XYs, opts ...Option) (Interpolator, error) {
+			return NewMakima(xys, opts...)
+		},
+		MethodSmoothingSpline: func(xys XYs, opts ...Option) (Interpolator, error) {
+			return NewSmoothingSpline(xys, opts...)
+		},
+		MethodMonotoneConvex: func(xys XYs, opts ...Option) (Interpolator, error) {
+			return NewMonotoneConvex(xys, opts...)
+		},
+		MethodPolynomial: func(xys XYs, opts ...Option) (Interpolator, error) {
+			return NewPolynomial(xys, opts...)
+		},
+		MethodBarycentricRational: func(xys XYs, opts ...Option) (Interpolator, error) {
+			return NewBarycentricRational(xys, opts...)
+		},
+	},
+}
+
+// New builds an interpolator using the method registered under the given name.
+func New(method string, xys XYs, opts ...Option) (Interpolator, error) {
+	registry.RLock()
+	constructor, ok := registry.constructors[method]
+	registry.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownMethod, method)
+	}
+
+	return constructor(xys, opts...)
+}
+
+// Register makes an interpolation method available to New under the given name.
+// The built-in methods cannot be replaced.
+func Register(method string, constructor Constructor) error {
+	if constructor == nil {
+		return fmt.Errorf("nil constructor for interpolation method %q", method)
+	}
+
+	registry.Lock()
+	defer registry.Unlock()
+
+	if _, ok := registry.constructors[method]; ok {
+		return fmt.Errorf("%w: %q", ErrMethodRegistered, method)
+	}
+	registry.constructors[method] = constructor
+
+	return nil
+}
+
+// Methods returns the sorted names of the registered interpolation methods.
+func Methods() []string {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	methods := make([]string, 0, len(registry.constructors))
+	for method := range registry.constructors {
+		methods = append(methods, method)
+	}
+	sort.Strings(methods)
+
+	return methods
+}
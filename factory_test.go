@@ -0,0 +1,161 @@
+package interpolator
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	testCases := []struct {
+		method   string
+		expected Interpolator
+	}{
+		{
+			MethodPiecewiseConstant,
+			&PiecewiseConstant{},
+		},
+		{
+			MethodPiecewiseLinear,
+			&PiecewiseLinear{},
+		},
+		{
+			MethodPiecewiseLinearThreshold,
+			&PiecewiseLinearThreshold{},
+		},
+		{
+			MethodPiecewiseLinearSqrt,
+			&PiecewiseLinearSqrt{},
+		},
+		{
+			MethodGeometric,
+			&Geometric{},
+		},
+		{
+			MethodGeometricSqrt,
+			&GeometricSqrt{},
+		},
+		{
+			MethodCubicSpline,
+			&CubicSpline{},
+		},
+		{
+			MethodMonotoneCubic,
+			&MonotoneCubic{},
+		},
+		{
+			MethodAkima,
+			&Akima{},
+		},
+		{
+			MethodMakima,
+			&Akima{},
+		},
+		{
+			MethodSmoothingSpline,
+			&SmoothingSpline{},
+		},
+		{
+			MethodMonotoneConvex,
+			&MonotoneConvex{},
+		},
+		{
+			MethodPolynomial,
+			&Polynomial{},
+		},
+		{
+			MethodBarycentricRational,
+			&BarycentricRational{},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.method, func(t *testing.T) {
+			interp, err := New(tc.method, testLinearXYs[1:])
+			require.NoError(t, err)
+			assert.IsType(t, tc.expected, interp)
+			assert.Contains(t, Methods(), tc.method)
+		})
+	}
+}
+
+func TestNewUnknownMethod(t *testing.T) {
+	_, err := New("unknown", testLinearXYs)
+	require.ErrorIs(t, err, ErrUnknownMethod)
+}
+
+func TestNewOptions(t *testing.T) {
+	interp, err := New(MethodPiecewiseLinear, testLinearXYs, WithExtrapolation(ExtrapolateFlat()))
+	require.NoError(t, err)
+	assert.InDelta(t, testLinearFunc(2.0), interp.Value(3.0), 1.0e-12)
+
+	unsorted := XYs{
+		{
+			X: 1.0,
+			Y: 2.0,
+		},
+		{
+			X: 0.0,
+			Y: 1.0,
+		},
+		{
+			X: 1.0,
+			Y: 4.0,
+		},
+	}
+
+	_, err = New(MethodGeometric, unsorted)
+	require.ErrorIs(t, err, ErrUnsorted)
+
+	interp, err = New(MethodGeometric, unsorted, WithPrepare(KeepLast))
+	require.NoError(t, err)
+	assert.InDelta(t, 2.0, interp.Value(0.5), 1.0e-12)
+
+	_, err = New(MethodGeometric, XYs{{X: 0.0, Y: -1.0}}, WithPrepare(KeepLast))
+	require.ErrorIs(t, err, ErrNonPositive)
+
+	_, err = New(MethodPiecewiseLinear, unsorted[:2], WithoutValidation())
+	require.NoError(t, err)
+}
+
+func TestRegister(t *testing.T) {
+	constructor := func(xys XYs, opts ...Option) (Interpolator, error) {
+		return NewPiecewiseLinear(xys, append(opts, WithExtrapolation(ExtrapolateNaN()))...)
+	}
+
+	require.NoError(t, Register("test_linear_nan", constructor))
+	require.ErrorIs(t, Register("test_linear_nan", constructor), ErrMethodRegistered)
+	require.ErrorIs(t, Register(MethodPiecewiseLinear, constructor), ErrMethodRegistered)
+	require.Error(t, Register("test_nil", nil))
+
+	interp, err := New("test_linear_nan", testLinearXYs)
+	require.NoError(t, err)
+	assert.InDelta(t, testLinearFunc(0.7), interp.Value(0.7), 1.0e-12)
+	assert.True(t, math.IsNaN(interp.Value(3.0)))
+}
+
+func ExampleNew() {
+	xys := XYs{
+		{
+			X: 0.0,
+			Y: 1.2,
+		},
+		{
+			X: 0.5,
+			Y: 1.0,
+		},
+		{
+			X: 1.0,
+			Y: 1.4,
+		},
+	}
+	interp, err := New("linear", xys, WithRightExtrapolation(ExtrapolateFlat()))
+	if err != nil {
+		return
+	}
+	fmt.Println(interp.Value(0.75), interp.Value(2.0))
+	// Output: 1.2 1.4
+}
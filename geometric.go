@@ -1,10 +1,6 @@
 package interpolator
 
-import (
-	"errors"
-	"fmt"
-	"math"
-)
+import "math"
 
 // epsilon is the numerical threshold under which values
 // are considered too close to 0 to be interpolated.
@@ -12,47 +8,113 @@ const epsilon = 1.0e-16
 
 // Geometric is a classic geometric interpolator.
 type Geometric struct {
-	xys XYs
+	*curve
 }
 
 // NewGeometric builds a geometric interpolator.
 // The input `xys` must be ordered, have unique abscissas
-// and positive ordinates.
-func NewGeometric(xys XYs) (*Geometric, error) {
-	if l := len(xys); l < 1 {
-		return nil, fmt.Errorf("at least 1 points is required to build a geometric interpolator, but got %d", l)
+// and positive ordinates, otherwise a *PointError is returned.
+// Jumps can be denoted by pairs of points sharing an abscissa, see WithJumps:
+// at a jump, the interpolator takes the ordinate of the second point.
+// By default, the edge segments are extended for extrapolation.
+func NewGeometric(xys XYs, opts ...Option) (*Geometric, error) {
+	cfg := newConfig(ExtrapolateEdge(), opts)
+
+	xys, err := cfg.prepareJumps("geometric", xys, XYs.validatePositive)
+	if err != nil {
+		return nil, err
 	}
 
-	for _, xy := range xys {
-		if xy.Y < epsilon {
-			return nil, errors.New("input xys must have non-negative ordinates")
-		}
+	c, err := newCurve(xys, cfg, newGeometricPiece)
+	if err != nil {
+		return nil, err
 	}
 
 	return &Geometric{
-		xys: xys,
+		curve: c,
 	}, nil
 }
 
 // Value compute the value of f(x) based on geometric interpolation.
 func (interp Geometric) Value(x float64) float64 {
-	if n := len(interp.xys); n == 1 {
-		return interp.xys[0].Y
-	}
+	return interp.value(x)
+}
 
-	p1, p2 := interp.xys.Interval(x)
-	lambda := (x - p1.X) / (p2.X - p1.X)
+// ValueLeft computes the limit of f at x from the left, which is f(x) except at the jumps,
+// where it is the ordinate of the first of the data points sharing the abscissa x.
+func (interp Geometric) ValueLeft(x float64) float64 {
+	return interp.valueLeft(x)
+}
 
-	return math.Pow(p1.Y, (1.0-lambda)) * math.Pow(p2.Y, lambda)
+// ValueRight computes the limit of f at x from the right, which is f(x):
+// at the jumps, f takes the ordinate of the second of the data points sharing the abscissa x.
+func (interp Geometric) ValueRight(x float64) float64 {
+	return interp.value(x)
 }
 
 // Gradient computes the gradient of f(x) based on geometric interpolation.
 func (interp Geometric) Gradient(x float64) float64 {
-	if n := len(interp.xys); n == 1 {
-		return 0.0
+	return interp.gradient(x)
+}
+
+// SecondDerivative computes the second derivative of f(x) based on geometric interpolation.
+func (interp Geometric) SecondDerivative(x float64) float64 {
+	return interp.secondDerivative(x)
+}
+
+// Inverse returns the abscissa x such that f(x) = y based on geometric interpolation.
+// The input data must have strictly monotone ordinates, otherwise an error wrapping ErrNotMonotone
+// is returned, and y must lie between the first and last ordinates, otherwise ErrOutOfRange is wrapped.
+func (interp Geometric) Inverse(y float64) (float64, error) {
+	return interp.inverse(y)
+}
+
+// geometricPiece is the geometric law between two points,
+// which is linear in the logarithm of the ordinates.
+type geometricPiece struct {
+	x0    float64
+	logY0 float64
+	rate  float64
+}
+
+func newGeometricPiece(p1, p2 XY) piece {
+	logY0 := math.Log(p1.Y)
+
+	return geometricPiece{
+		x0:    p1.X,
+		logY0: logY0,
+		rate:  (math.Log(p2.Y) - logY0) / (p2.X - p1.X),
 	}
+}
 
-	p1, p2 := interp.xys.Interval(x)
+func (p geometricPiece) value(x float64) float64 {
+	return math.Exp(math.FMA(p.rate, x-p.x0, p.logY0))
+}
+
+func (p geometricPiece) gradient(x float64) float64 {
+	return p.rate * p.value(x)
+}
+
+func (p geometricPiece) secondDerivative(x float64) float64 {
+	return p.rate * p.rate * p.value(x)
+}
+
+func (p geometricPiece) integral(a, b float64) float64 {
+	if p.rate == 0.0 {
+		return p.value(a) * (b - a)
+	}
+
+	return p.value(a) * math.Expm1(p.rate*(b-a)) / p.rate
+}
+
+func (p geometricPiece) inverse(y float64) float64 {
+	return p.x0 + (math.Log(y)-p.logY0)/p.rate
+}
+
+func (p geometricPiece) crossings(level, u, v, fu, fv float64, dst []Crossing) []Crossing {
+	return monotoneCrossings(p, level, u, v, fu, fv, dst)
+}
 
-	return math.Log(p2.Y/p1.Y) * interp.Value(x) / (p2.X - p1.X)
+func (p geometricPiece) extrema(u, v, fu, fv float64) (XY, XY) {
+	return monotoneExtrema(u, v, fu, fv)
 }
@@ -1,67 +1,157 @@
 package interpolator
 
-import (
-	"errors"
-	"fmt"
-	"math"
-)
+import "math"
 
 // GeometricSqrt performs a geometric interpolation with respect to the square root of the abscissae.
 type GeometricSqrt struct {
-	xys XYs
+	*curve
 }
 
 // NewGeometricSqrt builds a geometric sqrt interpolator.
 // The input `xys` must be ordered, have unique abscissas
-// and positive ordinates.
-func NewGeometricSqrt(xys XYs) (*GeometricSqrt, error) {
-	if l := len(xys); l < 1 {
-		return nil, fmt.Errorf("at least 1 points is required to build a geometric sqrt interpolator, but got %d", l)
+// and positive ordinates, otherwise a *PointError is returned.
+// By default, the extrapolation is flat.
+func NewGeometricSqrt(xys XYs, opts ...Option) (*GeometricSqrt, error) {
+	cfg := newConfig(ExtrapolateFlat(), opts)
+
+	xys, err := cfg.prepare("geometric sqrt", xys, XYs.validatePositive)
+	if err != nil {
+		return nil, err
 	}
 
-	for _, xy := range xys {
-		if xy.Y < epsilon {
-			return nil, errors.New("input xys must have non-negative ordinates")
-		}
+	c, err := newCurve(xys, cfg, newGeometricSqrtPiece)
+	if err != nil {
+		return nil, err
 	}
+	c.flatEnd()
 
 	return &GeometricSqrt{
-		xys: xys,
+		curve: c,
 	}, nil
 }
 
 // Value compute the value of f(x) based on geometric sqrt interpolation with flat extrapolation.
 func (interp GeometricSqrt) Value(x float64) float64 {
-	if n := len(interp.xys); n == 1 {
-		return interp.xys[0].Y
-	}
+	return interp.value(x)
+}
+
+// Gradient computes the gradient of f(x) based on geometric sqrt interpolation.
+// The gradient is infinite at the first point of each segment, so that 0 is returned at the data points
+// by convention, including the last one. ExtrapolateTangent follows the last segment with its derivative.
+func (interp GeometricSqrt) Gradient(x float64) float64 {
+	return interp.gradient(x)
+}
+
+// SecondDerivative computes the second derivative of f(x) based on geometric sqrt interpolation.
+// As the gradient, it is 0 at the data points by convention.
+func (interp GeometricSqrt) SecondDerivative(x float64) float64 {
+	return interp.secondDerivative(x)
+}
+
+// Inverse returns the abscissa x such that f(x) = y based on geometric sqrt interpolation.
+// The input data must have strictly monotone ordinates, otherwise an error wrapping ErrNotMonotone
+// is returned, and y must lie between the first and last ordinates, otherwise ErrOutOfRange is wrapped.
+func (interp GeometricSqrt) Inverse(y float64) (float64, error) {
+	return interp.inverse(y)
+}
 
-	p1, p2 := interp.xys.Interval(x)
-	if x <= p1.X {
-		return p1.Y
+// geometricSqrtPiece is the geometric law between two points
+// with respect to the square root of the normalized distance from the first point.
+type geometricSqrtPiece struct {
+	x0       float64
+	logY0    float64
+	dLogY    float64
+	invWidth float64
+}
+
+func newGeometricSqrtPiece(p1, p2 XY) piece {
+	logY0 := math.Log(p1.Y)
+
+	return geometricSqrtPiece{
+		x0:       p1.X,
+		logY0:    logY0,
+		dLogY:    math.Log(p2.Y) - logY0,
+		invWidth: 1.0 / (p2.X - p1.X),
 	}
-	if x >= p2.X {
-		return p2.Y
+}
+
+func (p geometricSqrtPiece) value(x float64) float64 {
+	return math.Exp(math.FMA(p.dLogY, math.Sqrt((x-p.x0)*p.invWidth), p.logY0))
+}
+
+// gradient is infinite at the first point of the segment, where 0 is returned by convention.
+func (p geometricSqrtPiece) gradient(x float64) float64 {
+	if x <= p.x0 {
+		return 0.0
 	}
 
-	lambda := math.Sqrt((x - p1.X) / (p2.X - p1.X))
+	lambda := math.Sqrt((x - p.x0) * p.invWidth)
 
-	return math.Pow(p1.Y, (1.0-lambda)) * math.Pow(p2.Y, lambda)
+	return 0.5 * p.dLogY * p.invWidth * math.Exp(math.FMA(p.dLogY, lambda, p.logY0)) / lambda
 }
 
-// Gradient computes the gradient of f(x) based on geometric sqrt interpolation.
-func (interp GeometricSqrt) Gradient(x float64) float64 {
-	if n := len(interp.xys); n == 1 {
+// secondDerivative is infinite at the first point of the segment, where 0 is returned by convention.
+func (p geometricSqrtPiece) secondDerivative(x float64) float64 {
+	if x <= p.x0 {
 		return 0.0
 	}
 
-	p1, p2 := interp.xys.Interval(x)
-	if x <= p1.X || x >= p2.X {
-		return 0.0
+	lambda := math.Sqrt((x - p.x0) * p.invWidth)
+	first := 0.5 * p.dLogY * p.invWidth / lambda
+	second := -0.5 * first * p.invWidth / (lambda * lambda)
+
+	return (first*first + second) * math.Exp(math.FMA(p.dLogY, lambda, p.logY0))
+}
+
+// integral substitutes s = sqrt((x-x0)/h), so that the integral of exp(log(y0) + dLogY*s)
+// becomes 2*h*y0 times the integral of s*exp(dLogY*s), which is s^2*expIntegral(dLogY*s).
+func (p geometricSqrtPiece) integral(a, b float64) float64 {
+	primitive := func(x float64) float64 {
+		s := math.Sqrt((x - p.x0) * p.invWidth)
+
+		return s * s * expIntegral(p.dLogY*s)
+	}
+
+	return 2.0 * math.Exp(p.logY0) / p.invWidth * (primitive(b) - primitive(a))
+}
+
+func (p geometricSqrtPiece) inverse(y float64) float64 {
+	lambda := (math.Log(y) - p.logY0) / p.dLogY
+
+	return p.x0 + lambda*lambda/p.invWidth
+}
+
+func (p geometricSqrtPiece) crossings(level, u, v, fu, fv float64, dst []Crossing) []Crossing {
+	return monotoneCrossings(p, level, u, v, fu, fv, dst)
+}
+
+func (p geometricSqrtPiece) extrema(u, v, fu, fv float64) (XY, XY) {
+	return monotoneExtrema(u, v, fu, fv)
+}
+
+// expIntegral computes (exp(z)*(z-1)+1)/z^2, which is the integral of t*exp(z*t) for t in [0, 1].
+// Its Taylor expansion is used close to 0 to avoid cancellations.
+func expIntegral(z float64) float64 {
+	if math.Abs(z) > 0.1 {
+		return (math.Exp(z)*(z-1.0) + 1.0) / (z * z)
 	}
 
-	h := p2.X - p1.X
-	lambda := math.Sqrt((x - p1.X) / h)
+	res := 0.0
+	for k := len(expIntegralSeries) - 1; k >= 0; k-- {
+		res = res*z + expIntegralSeries[k]
+	}
 
-	return 0.5 * math.Log(p2.Y/p1.Y) * math.Pow(p1.Y, (1.0-lambda)) * math.Pow(p2.Y, lambda) / (lambda * h)
+	return res
 }
+
+// expIntegralSeries holds the coefficients (k+1)/(k+2)! of the Taylor expansion of expIntegral.
+var expIntegralSeries = func() [12]float64 {
+	var coefs [12]float64
+	factorial := 2.0
+	for k := range coefs {
+		coefs[k] = float64(k+1) / factorial
+		factorial *= float64(k + 3)
+	}
+
+	return coefs
+}()
@@ -39,7 +39,21 @@ func TestNewGeometricSqrt(t *testing.T) {
 
 func TestNewGeometricSqrtEmptyXYs(t *testing.T) {
 	_, err := NewGeometricSqrt(XYs{})
-	require.Error(t, err)
+	require.ErrorIs(t, err, ErrNotEnoughPoints)
+}
+
+func TestNewGeometricSqrtUnsortedXYs(t *testing.T) {
+	_, err := NewGeometricSqrt(XYs{
+		{
+			X: 1.0,
+			Y: 1.0,
+		},
+		{
+			X: 0.0,
+			Y: 1.0,
+		},
+	})
+	require.ErrorIs(t, err, ErrUnsorted)
 }
 
 func TestNewGeometricSqrtSinglePoint(t *testing.T) {
@@ -73,7 +87,7 @@ func TestNewGeometricSqrtInvalidXYs(t *testing.T) {
 			Y: 1.0,
 		},
 	})
-	require.Error(t, err)
+	require.ErrorIs(t, err, ErrNonPositive)
 }
 
 func TestGeometricSqrtValue(t *testing.T) {
@@ -197,6 +211,23 @@ func TestGeometricSqrtGradient(t *testing.T) {
 	}
 }
 
+func TestGeometricSqrtGradientAtDataPoints(t *testing.T) {
+	interpolator, err := NewGeometricSqrt(XYs{{X: 0.0, Y: 1.0}, {X: 1.0, Y: 2.0}, {X: 2.0, Y: 4.0}}, WithExtrapolation(ExtrapolateEdge()))
+	require.NoError(t, err)
+
+	for _, x := range []float64{0.0, 1.0, 2.0} {
+		assert.Zero(t, interpolator.Gradient(x), "x=%v", x)
+	}
+
+	// The convention only applies at the data points, not to the extrapolation.
+	assert.InDelta(t, 2.0*math.Log(2.0), interpolator.Gradient(2.0+1.0e-12), 1.0e-6)
+
+	gradients := make([]float64, 2)
+	interpolator.GradientsInto(gradients, []float64{1.5, 2.0})
+	assert.Zero(t, gradients[1])
+	assert.Zero(t, NewEvaluator(interpolator).Gradient(2.0))
+}
+
 func ExampleGeometricSqrt_Gradient() {
 	xys := XYs{
 		{
@@ -226,11 +257,14 @@ func BenchmarkGeometricSqrtValue(b *testing.B) {
 	var (
 		x = 0.7
 		y = math.Exp(math.Sqrt(0.2) + math.Sqrt(0.5))
+		v float64
 	)
 	b.ResetTimer()
 	for n := 0; n < b.N; n++ {
-		assert.InDelta(b, y, interpolator.Value(x), 1.0e-8)
+		v = interpolator.Value(x)
 	}
+	b.StopTimer()
+	assert.InDelta(b, y, v, 1.0e-8)
 }
 
 func BenchmarkGeometricSqrtDerivative(b *testing.B) {
@@ -239,9 +273,19 @@ func BenchmarkGeometricSqrtDerivative(b *testing.B) {
 	var (
 		x = 0.7
 		y = math.Sqrt(0.5) * (math.Exp(math.Sqrt(0.2) + math.Sqrt(0.5))) / math.Sqrt(0.2/0.5)
+		v float64
 	)
 	b.ResetTimer()
 	for n := 0; n < b.N; n++ {
-		assert.InDelta(b, y, interpolator.Gradient(x), 1.0e-8)
+		v = interpolator.Gradient(x)
+	}
+	b.StopTimer()
+	assert.InDelta(b, y, v, 1.0e-8)
+}
+
+func TestExpIntegral(t *testing.T) {
+	assert.InDelta(t, 0.5, expIntegral(0.0), 1.0e-15)
+	for _, z := range []float64{-0.1, -0.05, 0.01, 0.1} {
+		assert.InEpsilon(t, (math.Exp(z)*(z-1.0)+1.0)/(z*z), expIntegral(z), 1.0e-10)
 	}
 }
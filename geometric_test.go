@@ -39,7 +39,21 @@ func TestNewGeometric(t *testing.T) {
 
 func TestNewGeometricEmptyXYs(t *testing.T) {
 	_, err := NewGeometric(XYs{})
-	require.Error(t, err)
+	require.ErrorIs(t, err, ErrNotEnoughPoints)
+}
+
+func TestNewGeometricUnsortedXYs(t *testing.T) {
+	_, err := NewGeometric(XYs{
+		{
+			X: 1.0,
+			Y: 1.0,
+		},
+		{
+			X: 0.0,
+			Y: 1.0,
+		},
+	})
+	require.ErrorIs(t, err, ErrUnsorted)
 }
 
 func TestNewGeometricSinglePoint(t *testing.T) {
@@ -73,7 +87,7 @@ func TestNewGeometricInvalidXYs(t *testing.T) {
 			Y: 1.0,
 		},
 	})
-	require.Error(t, err)
+	require.ErrorIs(t, err, ErrNonPositive)
 }
 
 func TestGeometricValue(t *testing.T) {
@@ -125,6 +139,38 @@ func TestGeometricValue(t *testing.T) {
 	}
 }
 
+func TestGeometricJumps(t *testing.T) {
+	const tol = 1.0e-12
+
+	interpolator, err := NewGeometric(XYs{
+		{
+			X: 0.0,
+			Y: 1.0,
+		},
+		{
+			X: 1.0,
+			Y: 2.0,
+		},
+		{
+			X: 1.0,
+			Y: 4.0,
+		},
+		{
+			X: 2.0,
+			Y: 8.0,
+		},
+	}, WithJumps())
+	require.NoError(t, err)
+
+	assert.InDelta(t, math.Sqrt(2.0), interpolator.Value(0.5), tol)
+	assert.InDelta(t, 4.0, interpolator.Value(1.0), tol)
+	assert.InDelta(t, 4.0, interpolator.ValueRight(1.0), tol)
+	assert.InDelta(t, 2.0, interpolator.ValueLeft(1.0), tol)
+	assert.InDelta(t, 4.0*math.Ln2, interpolator.Gradient(1.0), tol)
+	assert.InDelta(t, math.Sqrt(32.0), interpolator.Value(1.5), tol)
+	assert.InDelta(t, 1.0/math.Ln2+4.0/math.Ln2, interpolator.Integral(0.0, 2.0), tol)
+}
+
 func ExampleGeometric_Value() {
 	xys := XYs{
 		{
@@ -226,11 +272,14 @@ func BenchmarkGeometricValue(b *testing.B) {
 	var (
 		x = 0.7
 		y = math.Exp(x)
+		v float64
 	)
 	b.ResetTimer()
 	for n := 0; n < b.N; n++ {
-		assert.InDelta(b, y, interpolator.Value(x), 1.0e-8)
+		v = interpolator.Value(x)
 	}
+	b.StopTimer()
+	assert.InDelta(b, y, v, 1.0e-8)
 }
 
 func BenchmarkGeometricDerivative(b *testing.B) {
@@ -239,9 +288,21 @@ func BenchmarkGeometricDerivative(b *testing.B) {
 	var (
 		x = 0.7
 		y = math.Exp(x)
+		v float64
 	)
 	b.ResetTimer()
 	for n := 0; n < b.N; n++ {
-		assert.InDelta(b, y, interpolator.Gradient(x), 1.0e-8)
+		v = interpolator.Gradient(x)
+	}
+	b.StopTimer()
+	assert.InDelta(b, y, v, 1.0e-8)
+}
+
+func TestGeometricSecondDerivative(t *testing.T) {
+	interpolator, err := NewGeometric(testExpXYs)
+	require.NoError(t, err)
+
+	for _, x := range []float64{-1.0, 0.0, 0.3, 0.7, 1.2, 1.6, 6.0} {
+		assert.InEpsilon(t, math.Exp(x), interpolator.SecondDerivative(x), 1.0e-8)
 	}
 }
@@ -0,0 +1,47 @@
+package interpolator
+
+// Hermite is a cubic Hermite interpolator honouring both the values and the first derivatives
+// given at the data points.
+type Hermite struct {
+	*curve
+}
+
+// NewHermite builds a cubic Hermite interpolator from data points with derivatives.
+// The input `xyds` must be ordered and have unique abscissas,
+// otherwise a *PointError is returned.
+// By default, the edge segments are extended for extrapolation.
+func NewHermite(xyds XYDs, opts ...Option) (*Hermite, error) {
+	cfg := newConfig(ExtrapolateEdge(), opts)
+
+	xyds, err := cfg.prepareXYDs("hermite", xyds)
+	if err != nil {
+		return nil, err
+	}
+
+	xys, slopes := xyds.split()
+	c, err := newHermiteCurve(xys, slopes, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Hermite{
+		curve: c,
+	}, nil
+}
+
+// Value computes the value of f(x) based on cubic Hermite interpolation.
+func (interp Hermite) Value(x float64) float64 {
+	return interp.value(x)
+}
+
+// Gradient computes the gradient of f(x) based on cubic Hermite interpolation,
+// which is equal to the input derivatives at the data points.
+func (interp Hermite) Gradient(x float64) float64 {
+	return interp.gradient(x)
+}
+
+// SecondDerivative computes the second derivative of f(x) based on cubic Hermite interpolation,
+// which is discontinuous at the data points.
+func (interp Hermite) SecondDerivative(x float64) float64 {
+	return interp.secondDerivative(x)
+}
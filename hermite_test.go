@@ -0,0 +1,120 @@
+package interpolator
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testCubicXYDs samples testCubicFunc and its derivative.
+var testCubicXYDs = func() XYDs {
+	xyds := make(XYDs, len(testCubicXYs))
+	for i, xy := range testCubicXYs {
+		_, d, _ := testCubicFunc(xy.X)
+		xyds[i] = XYD{X: xy.X, Y: xy.Y, D: d}
+	}
+
+	return xyds
+}()
+
+func TestNewHermiteEmptyXYDs(t *testing.T) {
+	_, err := NewHermite(XYDs{})
+	require.ErrorIs(t, err, ErrNotEnoughPoints)
+}
+
+func TestNewHermiteInvalidXYDs(t *testing.T) {
+	_, err := NewHermite(XYDs{{X: 1.0, Y: 1.0, D: 0.0}, {X: 0.0, Y: 1.0, D: 0.0}})
+	require.ErrorIs(t, err, ErrUnsorted)
+
+	_, err = NewHermite(XYDs{{X: 0.0, Y: 1.0, D: math.NaN()}, {X: 1.0, Y: 1.0, D: 0.0}})
+	require.ErrorIs(t, err, ErrNotFinite)
+}
+
+func TestNewHermiteSinglePoint(t *testing.T) {
+	const tol = 1e-15
+
+	interpolator, err := NewHermite(XYDs{{X: 0.0, Y: 1.0, D: 2.0}})
+	require.NoError(t, err)
+
+	assert.InDelta(t, 1.0, interpolator.Value(-1.0), tol)
+	assert.InDelta(t, 1.0, interpolator.Value(0.0), tol)
+	assert.InDelta(t, 1.0, interpolator.Value(1.0), tol)
+
+	assert.InDelta(t, 0.0, interpolator.Gradient(-1.0), tol)
+	assert.InDelta(t, 0.0, interpolator.Gradient(0.0), tol)
+	assert.InDelta(t, 0.0, interpolator.Gradient(1.0), tol)
+}
+
+func TestHermiteReproducesCubic(t *testing.T) {
+	tolerance := 1.0e-10
+	interpolator, err := NewHermite(testCubicXYDs)
+	require.NoError(t, err)
+
+	for _, x := range []float64{-0.5, 0.0, 0.2, 0.4, 0.9, 1.3, 2.0, 2.9, 3.0, 3.5} {
+		y, dy, d2y := testCubicFunc(x)
+		assert.InDelta(t, y, interpolator.Value(x), tolerance, "x=%v", x)
+		assert.InDelta(t, dy, interpolator.Gradient(x), tolerance, "x=%v", x)
+		assert.InDelta(t, d2y, interpolator.SecondDerivative(x), tolerance, "x=%v", x)
+	}
+}
+
+func TestHermiteGradient(t *testing.T) {
+	xyds := XYDs{{X: 0.0, Y: 0.0, D: 1.0}, {X: 1.0, Y: 1.0, D: -3.0}, {X: 2.5, Y: 0.5, D: 0.0}}
+	interpolator, err := NewHermite(xyds, WithExtrapolation(ExtrapolateFlat()))
+	require.NoError(t, err)
+
+	for _, xyd := range xyds[:len(xyds)-1] {
+		assert.Equal(t, xyd.Y, interpolator.Value(xyd.X))
+		assert.InDelta(t, xyd.D, interpolator.Gradient(xyd.X), 1.0e-15)
+	}
+
+	// The derivative is continuous at the data points.
+	assert.InDelta(t, -3.0, interpolator.Gradient(1.0-1.0e-9), 1.0e-6)
+	assert.InDelta(t, 0.0, interpolator.Gradient(2.5), 1.0e-12)
+}
+
+func TestHermitePrepare(t *testing.T) {
+	interpolator, err := NewHermite(XYDs{{X: 1.0, Y: 1.0, D: 0.0}, {X: 0.0, Y: 0.0, D: 0.0}}, WithPrepare(RejectDuplicates))
+	require.NoError(t, err)
+	assert.InDelta(t, 0.5, interpolator.Value(0.5), 1.0e-15)
+}
+
+func ExampleHermite_Value() {
+	xyds := XYDs{
+		{
+			X: 0.0,
+			Y: 0.0,
+			D: 1.0,
+		},
+		{
+			X: 1.0,
+			Y: 1.0,
+			D: 0.0,
+		},
+	}
+	interp, err := NewHermite(xyds)
+	if err != nil {
+		return
+	}
+	fmt.Println(interp.Value(0.5))
+	// Output: 0.625
+}
+
+func BenchmarkHermiteValue(b *testing.B) {
+	interpolator, err := NewHermite(testCubicXYDs)
+	require.NoError(b, err)
+	var (
+		x       = 1.3
+		y, _, _ = testCubicFunc(x)
+		v       float64
+	)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		v = interpolator.Value(x)
+	}
+	b.StopTimer()
+	assert.InDelta(b, y, v, 1.0e-10)
+}
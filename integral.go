@@ -0,0 +1,79 @@
+package interpolator
+
+import "math"
+
+// Integral computes the integral of f between a and b,
+// including the parts lying in the extrapolation regions.
+// The integral is computed exactly from the interpolation law of each segment.
+// It is NaN if a or b is NaN.
+func (c *curve) Integral(a, b float64) float64 {
+	switch {
+	case math.IsNaN(a) || math.IsNaN(b):
+		return math.NaN()
+	case a == b:
+		return 0.0
+	case a > b:
+		return -c.Integral(b, a)
+	}
+
+	sum := 0.0
+	c.walk(a, b, func(_ int, u, v float64, p piece) {
+		sum += p.integral(u, v)
+	})
+
+	return sum
+}
+
+// Antiderivative returns the cumulative integral of f from the smallest abscissa of the input data.
+func (c *curve) Antiderivative() *Antiderivative {
+	cumulative := make([]float64, len(c.xs))
+	for i := 1; i < len(c.xs); i++ {
+		cumulative[i] = cumulative[i-1] + c.pieces[i-1].integral(c.xs[i-1], c.xs[i])
+	}
+
+	return &Antiderivative{
+		curve:      c,
+		cumulative: cumulative,
+	}
+}
+
+// Antiderivative is the cumulative integral F(x) of a piecewise interpolator f,
+// which is 0 at the smallest abscissa of the input data.
+// It is evaluated in O(log n) from the integrals of the segments, computed once for all.
+type Antiderivative struct {
+	curve      *curve
+	cumulative []float64
+}
+
+// Value computes F(x), the integral of f from the smallest abscissa of the input data to x.
+func (a *Antiderivative) Value(x float64) float64 {
+	c := a.curve
+	xMin, xMax := c.Domain()
+	switch {
+	case math.IsNaN(x):
+		return math.NaN()
+	case x < xMin:
+		return -c.left.integral(x, xMin)
+	case x > xMax:
+		return a.cumulative[len(a.cumulative)-1] + c.right.integral(xMax, x)
+	}
+
+	i := c.index(x)
+
+	return a.cumulative[i] + c.pieces[i].integral(c.xs[i], x)
+}
+
+// Gradient computes the gradient of F(x), which is f(x).
+func (a *Antiderivative) Gradient(x float64) float64 {
+	return a.curve.value(x)
+}
+
+// SecondDerivative computes the second derivative of F(x), which is f'(x).
+func (a *Antiderivative) SecondDerivative(x float64) float64 {
+	return a.curve.gradient(x)
+}
+
+// Domain returns the smallest and largest abscissas of the input data.
+func (a *Antiderivative) Domain() (float64, float64) {
+	return a.curve.Domain()
+}
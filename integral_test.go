@@ -0,0 +1,152 @@
+package interpolator
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testQuadrature integrates f between a and b with the composite Simpson rule,
+// splitting the interval at the given breakpoints.
+func testQuadrature(f func(float64) float64, a, b float64, breakpoints []float64) float64 {
+	const n = 20000
+
+	bounds := []float64{a}
+	for _, x := range breakpoints {
+		if x > a && x < b {
+			bounds = append(bounds, x)
+		}
+	}
+	bounds = append(bounds, b)
+
+	sum := 0.0
+	for k := 1; k < len(bounds); k++ {
+		lo, hi := bounds[k-1], bounds[k]
+		h := (hi - lo) / n
+		// Evaluate strictly inside the sub-interval, away from discontinuities at its bounds.
+		s := f(lo+h*1.0e-9) + f(hi-h*1.0e-9)
+		for i := 1; i < n; i++ {
+			w := 2.0
+			if i%2 == 1 {
+				w = 4.0
+			}
+			s += w * f(lo+float64(i)*h)
+		}
+		sum += s * h / 3.0
+	}
+
+	return sum
+}
+
+func TestIntegral(t *testing.T) {
+	breakpoints := []float64{0.0, 0.5, 1.0, 1.5, 2.0}
+	intervals := [][2]float64{
+		{0.2, 0.4},
+		{0.0, 2.0},
+		{0.3, 1.7},
+		{-1.0, 0.7},
+		{1.2, 3.5},
+		{-0.5, 2.5},
+	}
+
+	for _, method := range testBuiltinMethods {
+		for _, extrapolation := range []Extrapolation{ExtrapolateFlat(), ExtrapolateLinear(0.3), ExtrapolateConstant(2.0)} {
+			method, extrapolation := method, extrapolation
+			t.Run(fmt.Sprintf("%s/%v", method, extrapolation.kind), func(t *testing.T) {
+				interp, err := testNew(method, testExpXYs, WithExtrapolation(extrapolation))
+				require.NoError(t, err)
+
+				integrable, ok := interp.(Integrable)
+				require.True(t, ok)
+
+				for _, bounds := range intervals {
+					a, b := bounds[0], bounds[1]
+					expected := testQuadrature(interp.Value, a, b, breakpoints)
+					assert.InEpsilon(t, expected, integrable.Integral(a, b), 1.0e-6, "[%v, %v]", a, b)
+					assert.InDelta(t, -integrable.Integral(a, b), integrable.Integral(b, a), 1.0e-15)
+				}
+				assert.InDelta(t, 0.0, integrable.Integral(0.7, 0.7), 1.0e-15)
+			})
+		}
+	}
+}
+
+func TestIntegralExtendedEdges(t *testing.T) {
+	interpolator, err := NewGeometric(testExpXYs)
+	require.NoError(t, err)
+
+	assert.InEpsilon(t, math.Exp(3.0)-math.Exp(-1.0), interpolator.Integral(-1.0, 3.0), 1.0e-12)
+}
+
+func TestIntegralSinglePoint(t *testing.T) {
+	interpolator, err := NewPiecewiseLinear(XYs{{X: 1.0, Y: 2.0}})
+	require.NoError(t, err)
+
+	assert.InDelta(t, 6.0, interpolator.Integral(-1.0, 2.0), 1.0e-15)
+	assert.InDelta(t, 3.0, interpolator.Antiderivative().Value(2.5), 1.0e-15)
+}
+
+func TestAntiderivative(t *testing.T) {
+	for _, method := range testBuiltinMethods {
+		method := method
+		t.Run(method, func(t *testing.T) {
+			interp, err := testNew(method, testExpXYs, WithLeftExtrapolation(ExtrapolateLinear(0.5)))
+			require.NoError(t, err)
+
+			integrable, ok := interp.(interface {
+				Integrable
+				Antiderivative() *Antiderivative
+			})
+			require.True(t, ok)
+
+			antiderivative := integrable.Antiderivative()
+			for _, x := range []float64{-1.0, 0.0, 0.3, 0.5, 1.2, 2.0, 2.5} {
+				assert.InDelta(t, integrable.Integral(0.0, x), antiderivative.Value(x), 1.0e-12, "x=%v", x)
+				assert.InDelta(t, interp.Value(x), antiderivative.Gradient(x), 1.0e-15)
+			}
+		})
+	}
+}
+
+func TestIntegralNaN(t *testing.T) {
+	interpolator, err := NewPiecewiseLinear(XYs{{X: 0.0, Y: 0.0}, {X: 1.0, Y: 1.0}})
+	require.NoError(t, err)
+
+	assert.True(t, math.IsNaN(interpolator.Integral(0.0, math.NaN())))
+	assert.True(t, math.IsNaN(interpolator.Integral(math.NaN(), 1.0)))
+	assert.True(t, math.IsNaN(interpolator.Integral(math.NaN(), math.NaN())))
+}
+
+func TestAntiderivativeNaN(t *testing.T) {
+	interpolator, err := NewPiecewiseLinear(testLinearXYs)
+	require.NoError(t, err)
+
+	assert.True(t, math.IsNaN(interpolator.Antiderivative().Value(math.NaN())))
+}
+
+func ExamplePiecewiseConstant_Integral() {
+	// Survival probability from a piecewise constant hazard rate.
+	hazardRates := XYs{
+		{
+			X: 0.0,
+			Y: 0.01,
+		},
+		{
+			X: 1.0,
+			Y: 0.02,
+		},
+		{
+			X: 5.0,
+			Y: 0.03,
+		},
+	}
+	interp, err := NewPiecewiseConstant(hazardRates)
+	if err != nil {
+		return
+	}
+	fmt.Printf("%0.6f\n", math.Exp(-interp.Integral(0.0, 2.0)))
+	// Output: 0.970446
+}
@@ -0,0 +1,206 @@
+package interpolator
+
+// Interpolator is the common interface implemented by all the interpolators of the package.
+type Interpolator interface {
+	// Value computes the interpolated value f(x).
+	Value(x float64) float64
+	// Gradient computes the first derivative f'(x).
+	Gradient(x float64) float64
+}
+
+// Bounded is implemented by interpolators defined from a finite set of data points,
+// which can report the range of abscissas they were built on.
+type Bounded interface {
+	// Domain returns the smallest and largest abscissas of the input data.
+	Domain() (float64, float64)
+}
+
+// Tabulated is implemented by interpolators which can report the data points they were built on.
+type Tabulated interface {
+	// Points returns a copy of the input data points.
+	Points() XYs
+}
+
+// Vectorized is implemented by interpolators which can be evaluated on many abscissas at once,
+// without allocating.
+type Vectorized interface {
+	// ValuesInto computes the values f(x) at each of the `xs` into `dst`.
+	ValuesInto(dst, xs []float64)
+	// GradientsInto computes the gradients f'(x) at each of the `xs` into `dst`.
+	GradientsInto(dst, xs []float64)
+}
+
+// TwiceDifferentiable is implemented by interpolators which can compute their second derivative.
+//
+// The piecewise interpolators are not differentiable at their data points:
+// there, the derivatives are the ones of the segment on the right of the data point,
+// except at the last data point where they are the ones of the last segment.
+// The square root laws, whose derivatives are infinite at the first point of each segment,
+// return 0 at all the data points by convention, see PiecewiseLinearSqrt and GeometricSqrt.
+// The Dirac masses of the second derivative at the kinks of the curve are ignored.
+type TwiceDifferentiable interface {
+	// SecondDerivative computes the second derivative f''(x).
+	SecondDerivative(x float64) float64
+}
+
+// Integrable is implemented by interpolators which can compute their definite integral.
+type Integrable interface {
+	// Integral computes the integral of f between a and b.
+	Integral(a, b float64) float64
+}
+
+// Invertible is implemented by interpolators which can be inverted when their input data is strictly monotone.
+type Invertible interface {
+	// Inverse returns the abscissa x such that f(x) = y.
+	Inverse(y float64) (float64, error)
+}
+
+// Crosser is implemented by interpolators which can enumerate the abscissas where they cross a level.
+type Crosser interface {
+	// Crossings returns the abscissas in [a, b] where f crosses the level.
+	Crossings(level, a, b float64) []Crossing
+}
+
+// Ranger is implemented by interpolators which can compute their extrema over an interval.
+type Ranger interface {
+	// Range returns the points where f reaches its minimum and maximum on [a, b].
+	Range(a, b float64) (XY, XY)
+}
+
+var (
+	_ Interpolator = (*PiecewiseConstant)(nil)
+	_ Interpolator = (*PiecewiseLinear)(nil)
+	_ Interpolator = (*PiecewiseLinearThreshold)(nil)
+	_ Interpolator = (*PiecewiseLinearSqrt)(nil)
+	_ Interpolator = (*Geometric)(nil)
+	_ Interpolator = (*GeometricSqrt)(nil)
+	_ Interpolator = (*CubicSpline)(nil)
+	_ Interpolator = (*MonotoneCubic)(nil)
+	_ Interpolator = (*Akima)(nil)
+	_ Interpolator = (*Hermite)(nil)
+	_ Interpolator = (*SmoothingSpline)(nil)
+	_ Interpolator = (*MonotoneConvex)(nil)
+
+	_ Bounded = (*PiecewiseConstant)(nil)
+	_ Bounded = (*PiecewiseLinear)(nil)
+	_ Bounded = (*PiecewiseLinearThreshold)(nil)
+	_ Bounded = (*PiecewiseLinearSqrt)(nil)
+	_ Bounded = (*Geometric)(nil)
+	_ Bounded = (*GeometricSqrt)(nil)
+	_ Bounded = (*CubicSpline)(nil)
+	_ Bounded = (*MonotoneCubic)(nil)
+	_ Bounded = (*Akima)(nil)
+	_ Bounded = (*Hermite)(nil)
+	_ Bounded = (*SmoothingSpline)(nil)
+	_ Bounded = (*MonotoneConvex)(nil)
+
+	_ Tabulated = (*PiecewiseConstant)(nil)
+	_ Tabulated = (*PiecewiseLinear)(nil)
+	_ Tabulated = (*PiecewiseLinearThreshold)(nil)
+	_ Tabulated = (*PiecewiseLinearSqrt)(nil)
+	_ Tabulated = (*Geometric)(nil)
+	_ Tabulated = (*GeometricSqrt)(nil)
+	_ Tabulated = (*CubicSpline)(nil)
+	_ Tabulated = (*MonotoneCubic)(nil)
+	_ Tabulated = (*Akima)(nil)
+	_ Tabulated = (*Hermite)(nil)
+	_ Tabulated = (*SmoothingSpline)(nil)
+	_ Tabulated = (*MonotoneConvex)(nil)
+
+	_ Vectorized = (*PiecewiseConstant)(nil)
+	_ Vectorized = (*PiecewiseLinear)(nil)
+	_ Vectorized = (*PiecewiseLinearThreshold)(nil)
+	_ Vectorized = (*PiecewiseLinearSqrt)(nil)
+	_ Vectorized = (*Geometric)(nil)
+	_ Vectorized = (*GeometricSqrt)(nil)
+	_ Vectorized = (*CubicSpline)(nil)
+	_ Vectorized = (*MonotoneCubic)(nil)
+	_ Vectorized = (*Akima)(nil)
+	_ Vectorized = (*Hermite)(nil)
+	_ Vectorized = (*SmoothingSpline)(nil)
+	_ Vectorized = (*MonotoneConvex)(nil)
+
+	_ TwiceDifferentiable = (*PiecewiseConstant)(nil)
+	_ TwiceDifferentiable = (*PiecewiseLinear)(nil)
+	_ TwiceDifferentiable = (*PiecewiseLinearThreshold)(nil)
+	_ TwiceDifferentiable = (*PiecewiseLinearSqrt)(nil)
+	_ TwiceDifferentiable = (*Geometric)(nil)
+	_ TwiceDifferentiable = (*GeometricSqrt)(nil)
+	_ TwiceDifferentiable = (*CubicSpline)(nil)
+	_ TwiceDifferentiable = (*MonotoneCubic)(nil)
+	_ TwiceDifferentiable = (*Akima)(nil)
+	_ TwiceDifferentiable = (*Hermite)(nil)
+	_ TwiceDifferentiable = (*SmoothingSpline)(nil)
+	_ TwiceDifferentiable = (*MonotoneConvex)(nil)
+
+	_ Integrable = (*PiecewiseConstant)(nil)
+	_ Integrable = (*PiecewiseLinear)(nil)
+	_ Integrable = (*PiecewiseLinearThreshold)(nil)
+	_ Integrable = (*PiecewiseLinearSqrt)(nil)
+	_ Integrable = (*Geometric)(nil)
+	_ Integrable = (*GeometricSqrt)(nil)
+	_ Integrable = (*CubicSpline)(nil)
+	_ Integrable = (*MonotoneCubic)(nil)
+	_ Integrable = (*Akima)(nil)
+	_ Integrable = (*Hermite)(nil)
+	_ Integrable = (*SmoothingSpline)(nil)
+	_ Integrable = (*MonotoneConvex)(nil)
+
+	_ Invertible = (*PiecewiseLinear)(nil)
+	_ Invertible = (*PiecewiseLinearThreshold)(nil)
+	_ Invertible = (*PiecewiseLinearSqrt)(nil)
+	_ Invertible = (*Geometric)(nil)
+	_ Invertible = (*GeometricSqrt)(nil)
+	_ Invertible = (*MonotoneCubic)(nil)
+
+	_ Crosser = (*PiecewiseConstant)(nil)
+	_ Crosser = (*PiecewiseLinear)(nil)
+	_ Crosser = (*PiecewiseLinearThreshold)(nil)
+	_ Crosser = (*PiecewiseLinearSqrt)(nil)
+	_ Crosser = (*Geometric)(nil)
+	_ Crosser = (*GeometricSqrt)(nil)
+	_ Crosser = (*CubicSpline)(nil)
+	_ Crosser = (*MonotoneCubic)(nil)
+	_ Crosser = (*Akima)(nil)
+	_ Crosser = (*Hermite)(nil)
+	_ Crosser = (*SmoothingSpline)(nil)
+	_ Crosser = (*MonotoneConvex)(nil)
+
+	_ Ranger = (*PiecewiseConstant)(nil)
+	_ Ranger = (*PiecewiseLinear)(nil)
+	_ Ranger = (*PiecewiseLinearThreshold)(nil)
+	_ Ranger = (*PiecewiseLinearSqrt)(nil)
+	_ Ranger = (*Geometric)(nil)
+	_ Ranger = (*GeometricSqrt)(nil)
+	_ Ranger = (*CubicSpline)(nil)
+	_ Ranger = (*MonotoneCubic)(nil)
+	_ Ranger = (*Akima)(nil)
+	_ Ranger = (*Hermite)(nil)
+	_ Ranger = (*SmoothingSpline)(nil)
+	_ Ranger = (*MonotoneConvex)(nil)
+
+	_ Interpolator        = (*Polynomial)(nil)
+	_ Bounded             = (*Polynomial)(nil)
+	_ Tabulated           = (*Polynomial)(nil)
+	_ TwiceDifferentiable = (*Polynomial)(nil)
+
+	_ Interpolator        = (*BarycentricRational)(nil)
+	_ Bounded             = (*BarycentricRational)(nil)
+	_ Tabulated           = (*BarycentricRational)(nil)
+	_ TwiceDifferentiable = (*BarycentricRational)(nil)
+
+	_ Interpolator        = (*Chebyshev)(nil)
+	_ Bounded             = (*Chebyshev)(nil)
+	_ TwiceDifferentiable = (*Chebyshev)(nil)
+	_ Integrable          = (*Chebyshev)(nil)
+
+	_ Interpolator        = (*Transformed)(nil)
+	_ Bounded             = (*Transformed)(nil)
+	_ Tabulated           = (*Transformed)(nil)
+	_ TwiceDifferentiable = (*Transformed)(nil)
+	_ Invertible          = (*Transformed)(nil)
+
+	_ Interpolator        = (*Antiderivative)(nil)
+	_ TwiceDifferentiable = (*Antiderivative)(nil)
+	_ Bounded             = (*Antiderivative)(nil)
+)
@@ -0,0 +1,87 @@
+package interpolator
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInterpolators(t *testing.T) {
+	testCases := []struct {
+		name string
+		new  func(XYs) (Interpolator, error)
+	}{
+		{
+			"PiecewiseConstant",
+			func(xys XYs) (Interpolator, error) { return NewPiecewiseConstant(xys) },
+		},
+		{
+			"PiecewiseLinear",
+			func(xys XYs) (Interpolator, error) { return NewPiecewiseLinear(xys) },
+		},
+		{
+			"PiecewiseLinearThreshold",
+			func(xys XYs) (Interpolator, error) { return NewPiecewiseLinearThreshold(xys) },
+		},
+		{
+			"PiecewiseLinearSqrt",
+			func(xys XYs) (Interpolator, error) { return NewPiecewiseLinearSqrt(xys) },
+		},
+		{
+			"Geometric",
+			func(xys XYs) (Interpolator, error) { return NewGeometric(xys) },
+		},
+		{
+			"GeometricSqrt",
+			func(xys XYs) (Interpolator, error) { return NewGeometricSqrt(xys) },
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			interp, err := tc.new(testLinearXYs)
+			require.NoError(t, err)
+
+			for _, xy := range testLinearXYs {
+				assert.InEpsilon(t, xy.Y, interp.Value(xy.X), 1.0e-12)
+			}
+
+			bounded, ok := interp.(Bounded)
+			require.True(t, ok)
+			xMin, xMax := bounded.Domain()
+			assert.InDelta(t, 0.0, xMin, 1.0e-15)
+			assert.InDelta(t, 2.0, xMax, 1.0e-15)
+
+			tabulated, ok := interp.(Tabulated)
+			require.True(t, ok)
+			points := tabulated.Points()
+			assert.Equal(t, testLinearXYs, points)
+
+			points[0].Y = 100.0
+			assert.InEpsilon(t, testLinearXYs[0].Y, interp.Value(testLinearXYs[0].X), 1.0e-12)
+		})
+	}
+}
+
+func TestSecondDerivative(t *testing.T) {
+	const h = 1.0e-6
+
+	for _, method := range testBuiltinMethods {
+		method := method
+		t.Run(method, func(t *testing.T) {
+			interp, err := testNew(method, testExpXYs, WithExtrapolation(ExtrapolateEdge()))
+			require.NoError(t, err)
+
+			twice, ok := interp.(TwiceDifferentiable)
+			require.True(t, ok)
+
+			for _, x := range []float64{0.1, 0.3, 0.7, 1.2, 1.6, 1.9, 2.5} {
+				expected := (interp.Gradient(x+h) - interp.Gradient(x-h)) / (2.0 * h)
+				assert.InDelta(t, expected, twice.SecondDerivative(x), 1.0e-4*math.Max(1.0, math.Abs(expected)), "x=%v", x)
+				assert.InDelta(t, twice.SecondDerivative(x), NewEvaluator(interp).SecondDerivative(x), 1.0e-15)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package interpolator
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrNotMonotone is returned when inverting an interpolator whose input data is not strictly monotone.
+	ErrNotMonotone = errors.New("data is not strictly monotone")
+	// ErrNotInvertible is returned when inverting an interpolator whose interpolation law cannot be inverted.
+	ErrNotInvertible = errors.New("interpolation law is not invertible")
+	// ErrOutOfRange is returned when inverting an interpolator for an ordinate outside of the range of its input data.
+	ErrOutOfRange = errors.New("ordinate is out of range")
+)
+
+// inverter is implemented by the interpolation laws which are strictly monotone
+// between data points with different ordinates.
+type inverter interface {
+	// inverse returns the abscissa x such that the law at x is y.
+	inverse(y float64) float64
+}
+
+// monotony returns 1 if the ordinates of the `xys` are strictly increasing,
+// -1 if they are strictly decreasing, and 0 otherwise.
+func monotony(xys XYs) int {
+	if len(xys) < 2 {
+		return 0
+	}
+
+	sign := 1
+	if xys[1].Y < xys[0].Y {
+		sign = -1
+	}
+	for i := 1; i < len(xys); i++ {
+		if d := xys[i].Y - xys[i-1].Y; !(float64(sign)*d > 0.0) {
+			return 0
+		}
+	}
+
+	return sign
+}
+
+// inverse returns the abscissa x such that f(x) = y, solving analytically on the segment
+// bracketing y. The input data must have strictly monotone ordinates, otherwise an error
+// wrapping ErrNotMonotone is returned.
+// Only the domain of the input data is considered: an error wrapping ErrOutOfRange is returned
+// if y is not between the ordinates of the first and last points.
+func (c *curve) inverse(y float64) (float64, error) {
+	if c.monotone == 0 {
+		return 0.0, ErrNotMonotone
+	}
+
+	n := len(c.xys)
+	yFirst, yLast := c.xys[0].Y, c.xys[n-1].Y
+	if !(float64(c.monotone)*(y-yFirst) >= 0.0 && float64(c.monotone)*(yLast-y) >= 0.0) {
+		return 0.0, fmt.Errorf("%w: %v is not between %v and %v", ErrOutOfRange, y, yFirst, yLast)
+	}
+
+	// Find the first data point reaching y, with the ordinates in increasing order.
+	lo, hi := 0, n-1
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if float64(c.monotone)*(c.xys[mid].Y-y) >= 0.0 {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+	if c.xys[lo].Y == y {
+		return c.xs[lo], nil
+	}
+
+	i := lo - 1
+	inv, ok := c.pieces[i].(inverter)
+	if !ok {
+		return 0.0, ErrNotInvertible
+	}
+
+	// Keep the solution inside the segment in spite of rounding errors.
+	return min(max(inv.inverse(y), c.xs[i]), c.xs[i+1]), nil
+}
@@ -0,0 +1,113 @@
+package interpolator
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testInvertibleMethods are the built-in methods whose interpolation laws are monotone between data points.
+var testInvertibleMethods = []string{
+	MethodPiecewiseLinear,
+	MethodPiecewiseLinearThreshold,
+	MethodPiecewiseLinearSqrt,
+	MethodGeometric,
+	MethodGeometricSqrt,
+	MethodMonotoneCubic,
+}
+
+func TestInverse(t *testing.T) {
+	decreasing := make(XYs, len(testExpXYs))
+	for i, xy := range testExpXYs {
+		decreasing[i] = XY{X: xy.X, Y: 1.0 / xy.Y}
+	}
+
+	for _, method := range testInvertibleMethods {
+		for name, xys := range map[string]XYs{"Increasing": testExpXYs, "Decreasing": decreasing} {
+			method, xys := method, xys
+			t.Run(method+"/"+name, func(t *testing.T) {
+				interp, err := New(method, xys)
+				require.NoError(t, err)
+
+				invertible, ok := interp.(Invertible)
+				require.True(t, ok)
+
+				for _, x := range []float64{0.0, 0.1, 0.5, 0.7, 1.2, 1.5, 1.9, 2.0} {
+					res, err := invertible.Inverse(interp.Value(x))
+					require.NoError(t, err)
+					assert.InDelta(t, x, res, 1.0e-12)
+				}
+
+				_, err = invertible.Inverse(1.0e3)
+				require.ErrorIs(t, err, ErrOutOfRange)
+				_, err = invertible.Inverse(math.NaN())
+				require.ErrorIs(t, err, ErrOutOfRange)
+			})
+		}
+	}
+}
+
+func TestInverseErrors(t *testing.T) {
+	interpolator, err := NewPiecewiseLinear(XYs{{X: 0.0, Y: 1.0}, {X: 1.0, Y: 2.0}, {X: 2.0, Y: 2.0}})
+	require.NoError(t, err)
+	_, err = interpolator.Inverse(1.5)
+	require.ErrorIs(t, err, ErrNotMonotone)
+
+	single, err := NewPiecewiseLinear(XYs{{X: 0.0, Y: 1.0}})
+	require.NoError(t, err)
+	_, err = single.Inverse(1.0)
+	require.ErrorIs(t, err, ErrNotMonotone)
+
+	// The interpolators whose laws are not strictly monotone do not implement Invertible.
+	constant, err := NewPiecewiseConstant(testLinearXYs)
+	require.NoError(t, err)
+	_, ok := interface{}(constant).(Invertible)
+	assert.False(t, ok)
+}
+
+func TestNotInvertible(t *testing.T) {
+	for _, interp := range []interface{}{
+		(*PiecewiseConstant)(nil),
+		(*CubicSpline)(nil),
+		(*Akima)(nil),
+		(*Hermite)(nil),
+		(*SmoothingSpline)(nil),
+		(*MonotoneConvex)(nil),
+		(*Polynomial)(nil),
+		(*BarycentricRational)(nil),
+	} {
+		_, ok := interp.(Invertible)
+		assert.False(t, ok, "%T", interp)
+	}
+}
+
+func ExampleGeometric_Inverse() {
+	// Maturity at which a survival curve reaches 95%.
+	survival := XYs{
+		{
+			X: 0.0,
+			Y: 1.0,
+		},
+		{
+			X: 1.0,
+			Y: 0.98,
+		},
+		{
+			X: 5.0,
+			Y: 0.9,
+		},
+	}
+	interp, err := NewGeometric(survival)
+	if err != nil {
+		return
+	}
+	x, err := interp.Inverse(0.95)
+	if err != nil {
+		return
+	}
+	fmt.Printf("%0.4f\n", x)
+	// Output: 2.4604
+}
@@ -0,0 +1,139 @@
+package interpolator
+
+// solveTridiagonal solves the tridiagonal system with sub-diagonal `sub`, diagonal `diag`
+// and super-diagonal `sup`, whose first and last elements respectively are ignored.
+// The system must not require pivoting, which is the case of diagonally dominant systems.
+// The `diag` slice is overwritten, and the solution is stored in `rhs`.
+func solveTridiagonal(sub, diag, sup, rhs []float64) {
+	n := len(diag)
+	for i := 1; i < n; i++ {
+		w := sub[i] / diag[i-1]
+		diag[i] -= w * sup[i-1]
+		rhs[i] -= w * rhs[i-1]
+	}
+
+	rhs[n-1] /= diag[n-1]
+	for i := n - 2; i >= 0; i-- {
+		rhs[i] = (rhs[i] - sup[i]*rhs[i+1]) / diag[i]
+	}
+}
+
+// solveCyclicTridiagonal solves the cyclic tridiagonal system with sub-diagonal `sub`, diagonal `diag`
+// and super-diagonal `sup`, where the first element of `sub` is the top right corner of the matrix
+// and the last element of `sup` is its bottom left corner.
+// The `diag` slice is overwritten, and the solution is stored in `rhs`.
+func solveCyclicTridiagonal(sub, diag, sup, rhs []float64) {
+	n := len(diag)
+	switch n {
+	case 1:
+		rhs[0] /= sub[0] + diag[0] + sup[0]
+
+		return
+	case 2:
+		a, b := diag[0], sub[0]+sup[0]
+		c, d := sub[1]+sup[1], diag[1]
+		det := a*d - b*c
+		rhs[0], rhs[1] = (d*rhs[0]-b*rhs[1])/det, (a*rhs[1]-c*rhs[0])/det
+
+		return
+	}
+
+	// Sherman-Morrison formula, the corners being the rank one update u*v^T of a tridiagonal matrix
+	// with u = (gamma, 0, ..., 0, alpha) and v = (1, 0, ..., 0, beta/gamma).
+	alpha, beta := sup[n-1], sub[0]
+	gamma := -diag[0]
+	diag[0] -= gamma
+	diag[n-1] -= alpha * beta / gamma
+
+	u := make([]float64, n)
+	u[0], u[n-1] = gamma, alpha
+	diagU := make([]float64, n)
+	copy(diagU, diag)
+
+	solveTridiagonal(sub, diag, sup, rhs)
+	solveTridiagonal(sub, diagU, sup, u)
+
+	fact := (rhs[0] + beta*rhs[n-1]/gamma) / (1.0 + u[0] + beta*u[n-1]/gamma)
+	for i := range rhs {
+		rhs[i] -= fact * u[i]
+	}
+}
+
+// pentadiagonalLDL is the LDL^T decomposition of a symmetric positive definite pentadiagonal matrix,
+// where L is unit lower triangular with sub-diagonals `a` and `b`, and D is diagonal with elements `d`.
+type pentadiagonalLDL struct {
+	d []float64
+	a []float64
+	b []float64
+}
+
+// newPentadiagonalLDL decomposes the symmetric pentadiagonal matrix with diagonal `e0`,
+// first off-diagonal `e1` and second off-diagonal `e2`, the last elements of which are ignored.
+func newPentadiagonalLDL(e0, e1, e2 []float64) *pentadiagonalLDL {
+	n := len(e0)
+	f := &pentadiagonalLDL{
+		d: make([]float64, n),
+		a: make([]float64, n),
+		b: make([]float64, n),
+	}
+	for i := 0; i < n; i++ {
+		f.d[i] = e0[i]
+		if i >= 1 {
+			f.d[i] -= f.a[i-1] * f.a[i-1] * f.d[i-1]
+		}
+		if i >= 2 {
+			f.d[i] -= f.b[i-2] * f.b[i-2] * f.d[i-2]
+		}
+
+		if i+1 < n {
+			f.a[i] = e1[i]
+			if i >= 1 {
+				f.a[i] -= f.a[i-1] * f.b[i-1] * f.d[i-1]
+			}
+			f.a[i] /= f.d[i]
+		}
+		if i+2 < n {
+			f.b[i] = e2[i] / f.d[i]
+		}
+	}
+
+	return f
+}
+
+// solve solves the system in place, the solution being stored in `rhs`.
+func (f *pentadiagonalLDL) solve(rhs []float64) {
+	n := len(f.d)
+	for i := 1; i < n; i++ {
+		rhs[i] -= f.a[i-1] * rhs[i-1]
+		if i >= 2 {
+			rhs[i] -= f.b[i-2] * rhs[i-2]
+		}
+	}
+	for i := n - 1; i >= 0; i-- {
+		rhs[i] /= f.d[i]
+		if i+1 < n {
+			rhs[i] -= f.a[i] * rhs[i+1]
+		}
+		if i+2 < n {
+			rhs[i] -= f.b[i] * rhs[i+2]
+		}
+	}
+}
+
+// inverseBand returns the diagonal and the first two off-diagonals of the inverse of the matrix,
+// following Hutchinson and de Hoog (1985).
+func (f *pentadiagonalLDL) inverseBand() ([]float64, []float64, []float64) {
+	n := len(f.d)
+	s0, s1, s2 := make([]float64, n), make([]float64, n), make([]float64, n)
+	for i := n - 1; i >= 0; i-- {
+		if i+2 < n {
+			s2[i] = -f.a[i]*s1[i+1] - f.b[i]*s0[i+2]
+			s1[i] = -f.a[i]*s0[i+1] - f.b[i]*s1[i+1]
+		} else if i+1 < n {
+			s1[i] = -f.a[i] * s0[i+1]
+		}
+		s0[i] = 1.0/f.d[i] - f.a[i]*s1[i] - f.b[i]*s2[i]
+	}
+
+	return s0, s1, s2
+}
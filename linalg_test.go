@@ -0,0 +1,124 @@
+package interpolator
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSolveTridiagonal(t *testing.T) {
+	for _, n := range []int{1, 2, 3, 7} {
+		n := n
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			sub, diag, sup, rhs := testTridiagonal(n)
+			a, b := append([]float64(nil), diag...), append([]float64(nil), rhs...)
+
+			solveTridiagonal(sub, diag, sup, rhs)
+			for i := 0; i < n; i++ {
+				res := a[i] * rhs[i]
+				if i > 0 {
+					res += sub[i] * rhs[i-1]
+				}
+				if i < n-1 {
+					res += sup[i] * rhs[i+1]
+				}
+				assert.InDelta(t, b[i], res, 1.0e-12)
+			}
+		})
+	}
+}
+
+func TestSolveCyclicTridiagonal(t *testing.T) {
+	for _, n := range []int{1, 2, 3, 7} {
+		n := n
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			sub, diag, sup, rhs := testTridiagonal(n)
+			a, b := append([]float64(nil), diag...), append([]float64(nil), rhs...)
+
+			solveCyclicTridiagonal(sub, diag, sup, rhs)
+			for i := 0; i < n; i++ {
+				res := a[i]*rhs[i] + sub[i]*rhs[(i+n-1)%n] + sup[i]*rhs[(i+1)%n]
+				assert.InDelta(t, b[i], res, 1.0e-12)
+			}
+		})
+	}
+}
+
+// testTridiagonal returns a diagonally dominant tridiagonal system of size n.
+func testTridiagonal(n int) ([]float64, []float64, []float64, []float64) {
+	sub, diag, sup, rhs := make([]float64, n), make([]float64, n), make([]float64, n), make([]float64, n)
+	for i := 0; i < n; i++ {
+		sub[i] = 1.0 + 0.1*float64(i)
+		sup[i] = 0.5 - 0.05*float64(i)
+		diag[i] = 4.0 + float64(i%3)
+		rhs[i] = float64(i*i) - 3.0
+	}
+
+	return sub, diag, sup, rhs
+}
+
+func TestPentadiagonalLDL(t *testing.T) {
+	for _, n := range []int{1, 2, 3, 8} {
+		n := n
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			e0, e1, e2 := make([]float64, n), make([]float64, n), make([]float64, n)
+			for i := 0; i < n; i++ {
+				e0[i] = 6.0 + float64(i%4)
+				e1[i] = 1.5 - 0.2*float64(i)
+				e2[i] = 0.5 + 0.1*float64(i)
+			}
+			element := func(i, j int) float64 {
+				if i > j {
+					i, j = j, i
+				}
+				switch j - i {
+				case 0:
+					return e0[i]
+				case 1:
+					return e1[i]
+				case 2:
+					return e2[i]
+				}
+
+				return 0.0
+			}
+
+			ldl := newPentadiagonalLDL(e0, e1, e2)
+
+			// Recover the columns of the inverse by solving on the unit vectors.
+			inverse := make([][]float64, n)
+			for j := range inverse {
+				inverse[j] = make([]float64, n)
+				inverse[j][j] = 1.0
+				ldl.solve(inverse[j])
+				for i := 0; i < n; i++ {
+					res := 0.0
+					for k := 0; k < n; k++ {
+						res += element(i, k) * inverse[j][k]
+					}
+					assert.InDelta(t, float64(bool2int(i == j)), res, 1.0e-12)
+				}
+			}
+
+			s0, s1, s2 := ldl.inverseBand()
+			for i := 0; i < n; i++ {
+				assert.InDelta(t, inverse[i][i], s0[i], 1.0e-12)
+				if i+1 < n {
+					assert.InDelta(t, inverse[i][i+1], s1[i], 1.0e-12)
+				}
+				if i+2 < n {
+					assert.InDelta(t, inverse[i][i+2], s2[i], 1.0e-12)
+				}
+			}
+		})
+	}
+}
+
+func bool2int(b bool) int {
+	if b {
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,236 @@
+package interpolator
+
+import (
+	"errors"
+	"math"
+)
+
+// ErrInvalidOrigin is returned when building a yield curve whose first point is before the origin,
+// or at the origin with a non-zero interest.
+var ErrInvalidOrigin = errors.New("curve does not start at the origin")
+
+// MonotoneConvex is the monotone convex interpolator of Hagan and West (2006) for yield curves.
+// It interpolates the integrated forward rate, that is the zero rate times the maturity, so that
+// the instantaneous forward rate, its gradient, is continuous, and positive for positive discrete forwards.
+// On each interval between data points, the forward rate averages the discrete forward rate,
+// and stays monotone or convex.
+type MonotoneConvex struct {
+	*curve
+	points XYs
+}
+
+// NewMonotoneConvex builds a monotone convex interpolator from points of maturity and
+// zero rate times maturity, the curve going through the origin.
+// The input `xys` must be ordered and have unique abscissas,
+// otherwise a *PointError is returned.
+// By default, the curve is extrapolated with flat forward rates, see ExtrapolateTangent.
+func NewMonotoneConvex(xys XYs, opts ...Option) (*MonotoneConvex, error) {
+	cfg := newConfig(ExtrapolateTangent(), opts)
+
+	xys, err := cfg.prepare("monotone convex", xys)
+	if err != nil {
+		return nil, err
+	}
+
+	return newMonotoneConvex(xys, xys.Copy(), cfg)
+}
+
+// NewMonotoneConvexFromDiscounts builds a monotone convex interpolator from points of maturity and
+// discount factor, which must be positive, the discount factor being 1 at the origin.
+// The interpolator is the same as with NewMonotoneConvex on minus the logarithm of the discount factors.
+func NewMonotoneConvexFromDiscounts(xys XYs, opts ...Option) (*MonotoneConvex, error) {
+	cfg := newConfig(ExtrapolateTangent(), opts)
+
+	xys, err := cfg.prepare("monotone convex", xys, XYs.validatePositive)
+	if err != nil {
+		return nil, err
+	}
+
+	integrated := make(XYs, len(xys))
+	for i, xy := range xys {
+		integrated[i] = XY{X: xy.X, Y: -math.Log(xy.Y)}
+	}
+
+	return newMonotoneConvex(integrated, xys, cfg)
+}
+
+func newMonotoneConvex(xys, points XYs, cfg *config) (*MonotoneConvex, error) {
+	switch first := xys[0]; {
+	case first.X < 0.0:
+		return nil, &PointError{Index: 0, Point: points[0], Err: ErrInvalidOrigin}
+	case first.X == 0.0 && first.Y != 0.0:
+		return nil, &PointError{Index: 0, Point: points[0], Err: ErrInvalidOrigin}
+	case first.X > 0.0:
+		xys = append(XYs{{X: 0.0, Y: 0.0}}, xys...)
+	}
+
+	forwards := monotoneConvexForwards(xys, cfg.ameliorate)
+	pieces := make([]piece, len(xys)-1)
+	for i := range pieces {
+		pieces[i] = newMonotoneConvexPiece(xys[i], xys[i+1], forwards[i], forwards[i+1])
+	}
+
+	c, err := newCurveFromPieces(xys, cfg, pieces)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MonotoneConvex{
+		curve:  c,
+		points: points,
+	}, nil
+}
+
+// Value computes the zero rate times the maturity at x.
+func (interp MonotoneConvex) Value(x float64) float64 {
+	return interp.value(x)
+}
+
+// Gradient computes the instantaneous forward rate at x.
+func (interp MonotoneConvex) Gradient(x float64) float64 {
+	return interp.gradient(x)
+}
+
+// SecondDerivative computes the derivative of the instantaneous forward rate at x,
+// which is discontinuous at the data points.
+func (interp MonotoneConvex) SecondDerivative(x float64) float64 {
+	return interp.secondDerivative(x)
+}
+
+// Forward computes the instantaneous forward rate at x, which is the gradient of the interpolator.
+func (interp MonotoneConvex) Forward(x float64) float64 {
+	return interp.gradient(x)
+}
+
+// Rate computes the zero rate at x, which is the instantaneous forward rate at the origin.
+func (interp MonotoneConvex) Rate(x float64) float64 {
+	if x == 0.0 {
+		return interp.gradient(x)
+	}
+
+	return interp.value(x) / x
+}
+
+// Discount computes the discount factor at x.
+func (interp MonotoneConvex) Discount(x float64) float64 {
+	return math.Exp(-interp.value(x))
+}
+
+// Points returns a copy of the input data, without the origin if it was not provided.
+func (interp MonotoneConvex) Points() XYs {
+	return interp.points.Copy()
+}
+
+// monotoneConvexForwards returns the estimates of the instantaneous forward rates at the data points,
+// the first of which is the origin.
+func monotoneConvexForwards(xys XYs, ameliorate bool) []float64 {
+	n := len(xys) - 1
+	h := make([]float64, n)
+	discrete := make([]float64, n)
+	positive := true
+	for i := range h {
+		h[i] = xys[i+1].X - xys[i].X
+		discrete[i] = (xys[i+1].Y - xys[i].Y) / h[i]
+		positive = positive && discrete[i] >= 0.0
+	}
+
+	forwards := make([]float64, n+1)
+	switch n {
+	case 0:
+		// A single point at the origin gives a zero curve.
+		return forwards
+	case 1:
+		forwards[0], forwards[1] = discrete[0], discrete[0]
+
+		return forwards
+	}
+
+	// The inner forward rates are the values at the data points of the line
+	// going through the discrete forward rates at the middle of the adjacent intervals.
+	for i := 1; i < n; i++ {
+		forwards[i] = (h[i-1]*discrete[i] + h[i]*discrete[i-1]) / (h[i-1] + h[i])
+	}
+
+	if ameliorate {
+		// Extend the discrete forward rates linearly on fictitious intervals beyond both ends,
+		// as wide as the edge intervals, and estimate the end forward rates like the inner ones.
+		forwards[0] = discrete[0] - h[0]*(discrete[1]-discrete[0])/(h[0]+h[1])
+		forwards[n] = discrete[n-1] + h[n-1]*(discrete[n-1]-discrete[n-2])/(h[n-2]+h[n-1])
+	} else {
+		forwards[0] = discrete[0] - 0.5*(forwards[1]-discrete[0])
+		forwards[n] = discrete[n-1] - 0.5*(forwards[n-1]-discrete[n-1])
+	}
+
+	if positive {
+		forwards[0] = min(max(forwards[0], 0.0), 2.0*discrete[0])
+		for i := 1; i < n; i++ {
+			forwards[i] = min(max(forwards[i], 0.0), 2.0*min(discrete[i-1], discrete[i]))
+		}
+		forwards[n] = min(max(forwards[n], 0.0), 2.0*discrete[n-1])
+	}
+
+	return forwards
+}
+
+// newMonotoneConvexPiece returns the law between p1 and p2 whose gradient is the instantaneous forward rate
+// going from f1 to f2 and averaging the discrete forward rate.
+// The forward rate is the discrete one plus g(t), with t = (x - x1)/(x2 - x1), where g integrates to zero
+// and is quadratic on at most two parts, depending on g(0) and g(1).
+func newMonotoneConvexPiece(p1, p2 XY, f1, f2 float64) piece {
+	w := p2.X - p1.X
+	discrete := (p2.Y - p1.Y) / w
+	g0, g1 := f1-discrete, f2-discrete
+
+	// g is p + q*((eta-t)/eta)^2 on [0, eta] and p + r*((t-eta)/(1-eta))^2 on [eta, 1].
+	var eta, p, q, r float64
+	switch {
+	case g0 == 0.0 && g1 == 0.0:
+		return linearPiece{x0: p1.X, y0: p1.Y, slope: discrete}
+	case (g0 < 0.0 && -0.5*g0 <= g1 && g1 <= -2.0*g0) || (g0 > 0.0 && -0.5*g0 >= g1 && g1 >= -2.0*g0):
+		// g is g0*(1 - 4t + 3t^2) + g1*(-2t + 3t^2), which is monotone.
+		return cubicPiece{
+			x0: p1.X,
+			c0: p1.Y,
+			c1: discrete + g0,
+			c2: -(2.0*g0 + g1) / w,
+			c3: (g0 + g1) / (w * w),
+		}
+	case (g0 < 0.0 && g1 > -2.0*g0) || (g0 > 0.0 && g1 < -2.0*g0):
+		eta, p, r = (g1+2.0*g0)/(g1-g0), g0, g1-g0
+	case (g0 > 0.0 && 0.0 > g1 && g1 > -0.5*g0) || (g0 < 0.0 && 0.0 < g1 && g1 < -0.5*g0):
+		eta, p, q = 3.0*g1/(g1-g0), g1, g0-g1
+	default:
+		eta = g1 / (g1 + g0)
+		p = -g0 * g1 / (g0 + g1)
+		q, r = g0-p, g1-p
+	}
+
+	left := cubicPiece{
+		x0: p1.X,
+		c0: p1.Y,
+		c1: discrete + p + q,
+		c2: -q / (eta * w),
+		c3: q / (3.0 * eta * eta * w * w),
+	}
+	if eta >= 1.0 {
+		return left
+	}
+
+	at := p1.X + eta*w
+	right := cubicPiece{
+		x0: at,
+		c0: p1.Y,
+		c1: discrete + p,
+		c3: r / (3.0 * (1.0 - eta) * (1.0 - eta) * w * w),
+	}
+	if eta <= 0.0 {
+		return right
+	}
+	right.c0 = left.value(at)
+
+	return splitPiece{
+		at:    at,
+		left:  left,
+		right: right,
+	}
+}
@@ -0,0 +1,335 @@
+package interpolator
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testHaganWestXYs are the zero rates times maturities of the example of Hagan and West (2006),
+// with maturities 0.1, 1, 4, 9, 20 and 30 years and zero rates 8.1%, 7%, 4.4%, 7%, 4% and 3%.
+var testHaganWestXYs = XYs{
+	{
+		X: 0.1,
+		Y: 0.1 * 0.081,
+	},
+	{
+		X: 1.0,
+		Y: 1.0 * 0.07,
+	},
+	{
+		X: 4.0,
+		Y: 4.0 * 0.044,
+	},
+	{
+		X: 9.0,
+		Y: 9.0 * 0.07,
+	},
+	{
+		X: 20.0,
+		Y: 20.0 * 0.04,
+	},
+	{
+		X: 30.0,
+		Y: 30.0 * 0.03,
+	},
+}
+
+func testAmelioration(ameliorate bool) []Option {
+	if ameliorate {
+		return []Option{WithAmelioration()}
+	}
+
+	return nil
+}
+
+func TestNewMonotoneConvexEmptyXYs(t *testing.T) {
+	_, err := NewMonotoneConvex(XYs{})
+	require.ErrorIs(t, err, ErrNotEnoughPoints)
+}
+
+func TestNewMonotoneConvexUnsortedXYs(t *testing.T) {
+	_, err := NewMonotoneConvex(XYs{
+		{
+			X: 1.0,
+			Y: 0.05,
+		},
+		{
+			X: 0.5,
+			Y: 0.02,
+		},
+	})
+	require.ErrorIs(t, err, ErrUnsorted)
+}
+
+func TestNewMonotoneConvexInvalidOrigin(t *testing.T) {
+	testCases := []struct {
+		name string
+		xys  XYs
+	}{
+		{
+			"NegativeMaturity",
+			XYs{{X: -1.0, Y: 0.0}, {X: 1.0, Y: 0.05}},
+		},
+		{
+			"NonZeroOrigin",
+			XYs{{X: 0.0, Y: 0.01}, {X: 1.0, Y: 0.05}},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewMonotoneConvex(tc.xys)
+			require.ErrorIs(t, err, ErrInvalidOrigin)
+
+			var pointErr *PointError
+			require.ErrorAs(t, err, &pointErr)
+			assert.Equal(t, 0, pointErr.Index)
+		})
+	}
+}
+
+func TestNewMonotoneConvexFromDiscountsNonPositive(t *testing.T) {
+	_, err := NewMonotoneConvexFromDiscounts(XYs{
+		{
+			X: 1.0,
+			Y: 0.95,
+		},
+		{
+			X: 2.0,
+			Y: 0.0,
+		},
+	})
+	require.ErrorIs(t, err, ErrNonPositive)
+}
+
+func TestMonotoneConvexSinglePoint(t *testing.T) {
+	testCases := []struct {
+		name string
+		new  func(opts ...Option) (*MonotoneConvex, error)
+	}{
+		{
+			"Rates",
+			func(opts ...Option) (*MonotoneConvex, error) {
+				return NewMonotoneConvex(XYs{{X: 0.0, Y: 0.0}}, opts...)
+			},
+		},
+		{
+			"Discounts",
+			func(opts ...Option) (*MonotoneConvex, error) {
+				return NewMonotoneConvexFromDiscounts(XYs{{X: 0.0, Y: 1.0}}, opts...)
+			},
+		},
+	}
+	for _, tc := range testCases {
+		for _, ameliorate := range []bool{false, true} {
+			tc, ameliorate := tc, ameliorate
+			t.Run(fmt.Sprintf("%s/Ameliorate=%v", tc.name, ameliorate), func(t *testing.T) {
+				// A single point at the origin gives a zero curve.
+				interpolator, err := tc.new(testAmelioration(ameliorate)...)
+				require.NoError(t, err)
+
+				for _, x := range []float64{0.0, 1.0, 5.0} {
+					assert.Equal(t, 0.0, interpolator.Value(x))
+					assert.Equal(t, 0.0, interpolator.Forward(x))
+					assert.Equal(t, 0.0, interpolator.Rate(x))
+					assert.Equal(t, 1.0, interpolator.Discount(x))
+				}
+			})
+		}
+	}
+}
+
+func TestMonotoneConvexNodes(t *testing.T) {
+	interpolator, err := NewMonotoneConvex(testHaganWestXYs)
+	require.NoError(t, err)
+
+	assert.InDelta(t, 0.0, interpolator.Value(0.0), 1.0e-15)
+	for _, xy := range testHaganWestXYs {
+		assert.InDelta(t, xy.Y, interpolator.Value(xy.X), 1.0e-15)
+		assert.InDelta(t, xy.Y/xy.X, interpolator.Rate(xy.X), 1.0e-14)
+		assert.InDelta(t, math.Exp(-xy.Y), interpolator.Discount(xy.X), 1.0e-15)
+	}
+	assert.Equal(t, testHaganWestXYs, interpolator.Points())
+}
+
+// testHaganWestReference holds, for the example of Hagan and West (2006), the zero rate times the maturity Y
+// and the instantaneous forward rate D at some maturities X, without and with amelioration.
+// They were computed independently of this package, from the definitions of the paper in its notation
+// (estimates of the forward rates at the nodes, positivity collar and the four regions of the function g),
+// with the integrals of the forward rates evaluated by quadrature.
+var testHaganWestReference = map[bool]XYDs{
+	false: {
+		{X: 0.0, Y: 0.0, D: 0.0816111111111111},
+		{X: 0.05, Y: 0.00407291666666667, D: 0.0811527777777778},
+		{X: 0.1, Y: 0.0081, D: 0.0797777777777778},
+		{X: 0.5, Y: 0.0377314023425135, D: 0.0690275403608737},
+		{X: 1.0, Y: 0.07, D: 0.0610598290598291},
+		{X: 2.0, Y: 0.110201067654813, D: 0.0262411538926594},
+		{X: 4.0, Y: 0.176, D: 0.0561333333333333},
+		{X: 6.0, Y: 0.344730592521767, D: 0.105069443393727},
+		{X: 9.0, Y: 0.63, D: 0.0309090909090909},
+		{X: 15.0, Y: 0.737012987012987, D: 0.0125974025974026},
+		{X: 20.0, Y: 0.8, D: 0.0125974025974026},
+		{X: 25.0, Y: 0.85487012987013, D: 0.00967532467532466},
+		{X: 30.0, Y: 0.9, D: 0.00870129870129869},
+	},
+	true: {
+		{X: 0.0, Y: 0.0, D: 0.0822222222222222},
+		{X: 0.05, Y: 0.00408055555555556, D: 0.081},
+		{X: 0.1, Y: 0.0081, D: 0.0797777777777778},
+		{X: 0.5, Y: 0.0377314023425135, D: 0.0690275403608737},
+		{X: 1.0, Y: 0.07, D: 0.0610598290598291},
+		{X: 2.0, Y: 0.110201067654813, D: 0.0262411538926594},
+		{X: 4.0, Y: 0.176, D: 0.0561333333333333},
+		{X: 6.0, Y: 0.344730592521767, D: 0.105069443393727},
+		{X: 9.0, Y: 0.63, D: 0.0309090909090909},
+		{X: 15.0, Y: 0.737012987012987, D: 0.0125974025974026},
+		{X: 20.0, Y: 0.8, D: 0.0125974025974026},
+		{X: 25.0, Y: 0.856493506493507, D: 0.00999999999999999},
+		{X: 30.0, Y: 0.9, D: 0.00740259740259739},
+	},
+}
+
+func TestMonotoneConvexReference(t *testing.T) {
+	const tol = 1.0e-14
+
+	for _, ameliorate := range []bool{false, true} {
+		ameliorate := ameliorate
+		t.Run(fmt.Sprintf("Ameliorate=%v", ameliorate), func(t *testing.T) {
+			interpolator, err := NewMonotoneConvex(testHaganWestXYs, testAmelioration(ameliorate)...)
+			require.NoError(t, err)
+
+			for _, xyd := range testHaganWestReference[ameliorate] {
+				assert.InDelta(t, xyd.Y, interpolator.Value(xyd.X), tol, "x=%v", xyd.X)
+				assert.InDelta(t, xyd.D, interpolator.Forward(xyd.X), tol, "x=%v", xyd.X)
+				assert.InDelta(t, xyd.D, interpolator.Gradient(xyd.X), tol, "x=%v", xyd.X)
+			}
+		})
+	}
+}
+
+func TestMonotoneConvexShape(t *testing.T) {
+	for _, ameliorate := range []bool{false, true} {
+		ameliorate := ameliorate
+		t.Run(fmt.Sprintf("Ameliorate=%v", ameliorate), func(t *testing.T) {
+			interpolator, err := NewMonotoneConvex(testHaganWestXYs, testAmelioration(ameliorate)...)
+			require.NoError(t, err)
+
+			xys := append(XYs{{}}, testHaganWestXYs...)
+			for i := 1; i < len(xys); i++ {
+				// The forward rate averages the discrete forward rate on each interval.
+				assert.InDelta(t, xys[i].Y-xys[i-1].Y, interpolator.Value(xys[i].X)-interpolator.Value(xys[i-1].X), 1.0e-14)
+
+				// The forward rate is positive, since all the discrete forward rates are.
+				for _, x := range testGrid(xys[i-1].X, xys[i].X, 101) {
+					assert.GreaterOrEqual(t, interpolator.Forward(x), 0.0, "x=%v", x)
+				}
+			}
+
+			// The forward rate is continuous at the data points.
+			const h = 1.0e-9
+			for _, xy := range testHaganWestXYs[:len(testHaganWestXYs)-1] {
+				assert.InDelta(t, interpolator.Forward(xy.X), interpolator.Forward(xy.X-h), 1.0e-6, "x=%v", xy.X)
+				assert.InDelta(t, interpolator.Forward(xy.X), interpolator.Forward(xy.X+h), 1.0e-6, "x=%v", xy.X)
+			}
+		})
+	}
+}
+
+func TestMonotoneConvexFlatForward(t *testing.T) {
+	const rate = 0.05
+
+	xys := XYs{
+		{
+			X: 0.5,
+			Y: 0.5 * rate,
+		},
+		{
+			X: 2.0,
+			Y: 2.0 * rate,
+		},
+		{
+			X: 10.0,
+			Y: 10.0 * rate,
+		},
+	}
+	for _, ameliorate := range []bool{false, true} {
+		interpolator, err := NewMonotoneConvex(xys, testAmelioration(ameliorate)...)
+		require.NoError(t, err)
+		for _, x := range []float64{0.0, 0.25, 1.0, 5.0, 10.0, 15.0} {
+			assert.InDelta(t, rate, interpolator.Forward(x), 1.0e-15)
+			assert.InDelta(t, rate, interpolator.Rate(x), 1.0e-15)
+			assert.InDelta(t, 0.0, interpolator.SecondDerivative(x), 1.0e-15)
+		}
+	}
+}
+
+func TestMonotoneConvexFromDiscounts(t *testing.T) {
+	discounts := make(XYs, len(testHaganWestXYs))
+	for i, xy := range testHaganWestXYs {
+		discounts[i] = XY{X: xy.X, Y: math.Exp(-xy.Y)}
+	}
+
+	fromRates, err := NewMonotoneConvex(testHaganWestXYs)
+	require.NoError(t, err)
+	fromDiscounts, err := NewMonotoneConvexFromDiscounts(discounts)
+	require.NoError(t, err)
+
+	assert.Equal(t, discounts, fromDiscounts.Points())
+	for _, x := range testGrid(0.0, 35.0, 71) {
+		assert.InDelta(t, fromRates.Value(x), fromDiscounts.Value(x), 1.0e-14)
+		assert.InDelta(t, fromRates.Forward(x), fromDiscounts.Forward(x), 1.0e-12)
+		assert.InDelta(t, fromRates.Discount(x), fromDiscounts.Discount(x), 1.0e-14)
+	}
+}
+
+func TestMonotoneConvexExtrapolation(t *testing.T) {
+	interpolator, err := NewMonotoneConvex(testHaganWestXYs)
+	require.NoError(t, err)
+
+	last := testHaganWestXYs[len(testHaganWestXYs)-1]
+	forward := interpolator.Forward(last.X)
+	for _, x := range []float64{31.0, 40.0, 50.0} {
+		assert.InDelta(t, forward, interpolator.Forward(x), 1.0e-15)
+		assert.InDelta(t, last.Y+forward*(x-last.X), interpolator.Value(x), 1.0e-14)
+	}
+}
+
+func ExampleMonotoneConvex_Forward() {
+	xys := XYs{
+		{
+			X: 1.0,
+			Y: 0.02,
+		},
+		{
+			X: 2.0,
+			Y: 0.05,
+		},
+	}
+	interp, err := NewMonotoneConvex(xys)
+	if err != nil {
+		return
+	}
+	fmt.Printf("%.4f\n", interp.Forward(1.0))
+	// Output: 0.0250
+}
+
+func BenchmarkMonotoneConvexValue(b *testing.B) {
+	interpolator, err := NewMonotoneConvex(testHaganWestXYs)
+	require.NoError(b, err)
+	var (
+		x = 4.0
+		y = 0.176
+		v float64
+	)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		v = interpolator.Value(x)
+	}
+	b.StopTimer()
+	assert.InDelta(b, y, v, 1.0e-12)
+}
@@ -0,0 +1,182 @@
+package interpolator
+
+import (
+	"fmt"
+	"math"
+)
+
+// Limiter defines how the slopes of a monotone cubic interpolator are computed
+// so that the interpolation does not overshoot between data points.
+type Limiter int
+
+const (
+	// LimiterFritschCarlson computes the slopes as weighted harmonic means of the adjacent secants,
+	// following Fritsch and Carlson (PCHIP), with shape-preserving three-point slopes at both ends.
+	LimiterFritschCarlson Limiter = iota
+	// LimiterSteffen computes the slopes from the parabola going through three consecutive points,
+	// limited to twice the adjacent secants, following Steffen (1990).
+	LimiterSteffen
+)
+
+// MonotoneCubic is a cubic Hermite interpolator preserving the monotony of the input data:
+// it is continuously differentiable, monotone between consecutive data points,
+// and its local extrema are at data points.
+type MonotoneCubic struct {
+	*curve
+}
+
+// NewMonotoneCubic builds a monotone cubic interpolator.
+// The input `xys` must be ordered and have unique abscissas,
+// otherwise a *PointError is returned.
+// By default, the slopes are computed with the Fritsch-Carlson limiter, see WithLimiter,
+// and the ordinate of the closest data point is used for extrapolation, which preserves the monotony.
+func NewMonotoneCubic(xys XYs, opts ...Option) (*MonotoneCubic, error) {
+	cfg := newConfig(ExtrapolateFlat(), opts)
+
+	xys, err := cfg.prepare("monotone cubic", xys)
+	if err != nil {
+		return nil, err
+	}
+
+	slopes, err := monotoneSlopes(xys, cfg.limiter)
+	if err != nil {
+		return nil, err
+	}
+
+	pieces := make([]piece, len(xys)-1)
+	for i := range pieces {
+		pieces[i] = monotoneCubicPiece{
+			cubicPiece: newCubicPiece(xys[i], xys[i+1], slopes[i], slopes[i+1]),
+			x1:         xys[i+1].X,
+		}
+	}
+
+	c, err := newCurveFromPieces(xys, cfg, pieces)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MonotoneCubic{
+		curve: c,
+	}, nil
+}
+
+// Value computes the value of f(x) based on monotone cubic interpolation.
+func (interp MonotoneCubic) Value(x float64) float64 {
+	return interp.value(x)
+}
+
+// Gradient computes the gradient of f(x) based on monotone cubic interpolation.
+func (interp MonotoneCubic) Gradient(x float64) float64 {
+	return interp.gradient(x)
+}
+
+// SecondDerivative computes the second derivative of f(x) based on monotone cubic interpolation,
+// which is discontinuous at the data points.
+func (interp MonotoneCubic) SecondDerivative(x float64) float64 {
+	return interp.secondDerivative(x)
+}
+
+// Inverse returns the abscissa x such that f(x) = y based on monotone cubic interpolation.
+// The input data must have strictly monotone ordinates, otherwise an error wrapping ErrNotMonotone
+// is returned, and y must lie between the first and last ordinates, otherwise ErrOutOfRange is wrapped.
+func (interp MonotoneCubic) Inverse(y float64) (float64, error) {
+	return interp.inverse(y)
+}
+
+// monotoneSlopes returns the first derivatives at the data points computed with the given limiter.
+func monotoneSlopes(xys XYs, limiter Limiter) ([]float64, error) {
+	n := len(xys)
+	slopes := make([]float64, n)
+	if n < 2 {
+		return slopes, nil
+	}
+
+	h := make([]float64, n-1)
+	delta := make([]float64, n-1)
+	for i := range h {
+		h[i] = xys[i+1].X - xys[i].X
+		delta[i] = (xys[i+1].Y - xys[i].Y) / h[i]
+	}
+
+	if n == 2 {
+		slopes[0], slopes[1] = delta[0], delta[0]
+
+		return slopes, nil
+	}
+
+	switch limiter {
+	case LimiterFritschCarlson:
+		for i := 1; i < n-1; i++ {
+			if delta[i-1]*delta[i] <= 0.0 {
+				continue
+			}
+			w1, w2 := 2.0*h[i]+h[i-1], h[i]+2.0*h[i-1]
+			slopes[i] = (w1 + w2) / (w1/delta[i-1] + w2/delta[i])
+		}
+		slopes[0] = fritschCarlsonEndSlope(h[0], h[1], delta[0], delta[1])
+		slopes[n-1] = fritschCarlsonEndSlope(h[n-2], h[n-3], delta[n-2], delta[n-3])
+	case LimiterSteffen:
+		for i := 1; i < n-1; i++ {
+			p := (delta[i-1]*h[i] + delta[i]*h[i-1]) / (h[i-1] + h[i])
+			bound := math.Min(math.Min(math.Abs(delta[i-1]), math.Abs(delta[i])), 0.5*math.Abs(p))
+			slopes[i] = (sign(delta[i-1]) + sign(delta[i])) * bound
+		}
+		slopes[0] = steffenEndSlope(h[0], h[1], delta[0], delta[1])
+		slopes[n-1] = steffenEndSlope(h[n-2], h[n-3], delta[n-2], delta[n-3])
+	default:
+		return nil, fmt.Errorf("unknown limiter %d", limiter)
+	}
+
+	return slopes, nil
+}
+
+// fritschCarlsonEndSlope returns the slope at an end point from the shape-preserving three-point formula,
+// given the widths and secants of the edge segment and of its neighbour.
+func fritschCarlsonEndSlope(h0, h1, delta0, delta1 float64) float64 {
+	d := ((2.0*h0+h1)*delta0 - h0*delta1) / (h0 + h1)
+	switch {
+	case sign(d) != sign(delta0):
+		return 0.0
+	case sign(delta0) != sign(delta1) && math.Abs(d) > 3.0*math.Abs(delta0):
+		return 3.0 * delta0
+	}
+
+	return d
+}
+
+// steffenEndSlope returns the slope at an end point from the parabola going through the three edge points,
+// limited to twice the secant of the edge segment.
+func steffenEndSlope(h0, h1, delta0, delta1 float64) float64 {
+	d := delta0*(1.0+h0/(h0+h1)) - delta1*h0/(h0+h1)
+	switch {
+	case d*delta0 <= 0.0:
+		return 0.0
+	case math.Abs(d) > 2.0*math.Abs(delta0):
+		return 2.0 * delta0
+	}
+
+	return d
+}
+
+// sign returns the sign of x, and 0 for 0.
+func sign(x float64) float64 {
+	switch {
+	case x > 0.0:
+		return 1.0
+	case x < 0.0:
+		return -1.0
+	}
+
+	return 0.0
+}
+
+// monotoneCubicPiece is a cubic law which is monotone on its segment [x0, x1].
+type monotoneCubicPiece struct {
+	cubicPiece
+	x1 float64
+}
+
+func (p monotoneCubicPiece) inverse(y float64) float64 {
+	return p.solve(y, p.x0, p.x1, p.c0)
+}
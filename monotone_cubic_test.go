@@ -0,0 +1,249 @@
+package interpolator
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testSquareXYs = XYs{
+	{
+		X: 0.0,
+		Y: 0.0,
+	},
+	{
+		X: 1.0,
+		Y: 1.0,
+	},
+	{
+		X: 2.0,
+		Y: 4.0,
+	},
+	{
+		X: 3.0,
+		Y: 9.0,
+	},
+}
+
+func TestNewMonotoneCubicEmptyXYs(t *testing.T) {
+	_, err := NewMonotoneCubic(XYs{})
+	require.ErrorIs(t, err, ErrNotEnoughPoints)
+}
+
+func TestNewMonotoneCubicUnsortedXYs(t *testing.T) {
+	_, err := NewMonotoneCubic(XYs{
+		{
+			X: 1.0,
+			Y: 1.0,
+		},
+		{
+			X: 0.0,
+			Y: 1.0,
+		},
+	})
+	require.ErrorIs(t, err, ErrUnsorted)
+}
+
+func TestNewMonotoneCubicUnknownLimiter(t *testing.T) {
+	_, err := NewMonotoneCubic(testSquareXYs, WithLimiter(Limiter(-1)))
+	require.Error(t, err)
+}
+
+func TestNewMonotoneCubicSinglePoint(t *testing.T) {
+	const tol = 1e-15
+
+	interpolator, err := NewMonotoneCubic(XYs{
+		{
+			X: 0.0,
+			Y: 1.0,
+		},
+	})
+	require.NoError(t, err)
+
+	assert.InDelta(t, 1.0, interpolator.Value(-1.0), tol)
+	assert.InDelta(t, 1.0, interpolator.Value(0.0), tol)
+	assert.InDelta(t, 1.0, interpolator.Value(1.0), tol)
+
+	assert.InDelta(t, 0.0, interpolator.Gradient(-1.0), tol)
+	assert.InDelta(t, 0.0, interpolator.Gradient(0.0), tol)
+	assert.InDelta(t, 0.0, interpolator.Gradient(1.0), tol)
+}
+
+func TestMonotoneCubicValue(t *testing.T) {
+	tolerance := 1.0e-12
+
+	testCases := []struct {
+		name     string
+		limiter  Limiter
+		input    float64
+		expected float64
+		gradient float64
+	}{
+		{
+			"FritschCarlson1",
+			LimiterFritschCarlson,
+			0.5,
+			0.3125,
+			1.125,
+		},
+		{
+			"FritschCarlson2",
+			LimiterFritschCarlson,
+			1.5,
+			2.5 - 2.25/8.0,
+			4.5 - 0.25*(1.5+3.75),
+		},
+		{
+			"FritschCarlsonKnot",
+			LimiterFritschCarlson,
+			2.0,
+			4.0,
+			3.75,
+		},
+		{
+			"Steffen1",
+			LimiterSteffen,
+			0.5,
+			0.25,
+			1.0,
+		},
+		{
+			"Steffen2",
+			LimiterSteffen,
+			1.5,
+			2.25,
+			3.0,
+		},
+		{
+			"SteffenKnot",
+			LimiterSteffen,
+			3.0,
+			9.0,
+			6.0,
+		},
+		{
+			"RightExtrapolation",
+			LimiterSteffen,
+			4.0,
+			9.0,
+			0.0,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			interpolator, err := NewMonotoneCubic(testSquareXYs, WithLimiter(tc.limiter))
+			require.NoError(t, err)
+			assert.InDelta(t, tc.expected, interpolator.Value(tc.input), tolerance)
+			assert.InDelta(t, tc.gradient, interpolator.Gradient(tc.input), tolerance)
+		})
+	}
+}
+
+func ExampleMonotoneCubic_Value() {
+	xys := XYs{
+		{
+			X: 0.0,
+			Y: 0.0,
+		},
+		{
+			X: 1.0,
+			Y: 1.0,
+		},
+		{
+			X: 2.0,
+			Y: 4.0,
+		},
+		{
+			X: 3.0,
+			Y: 9.0,
+		},
+	}
+	interp, err := NewMonotoneCubic(xys)
+	if err != nil {
+		return
+	}
+	fmt.Println(interp.Value(0.5))
+	// Output: 0.3125
+}
+
+func TestMonotoneCubicNoOvershoot(t *testing.T) {
+	xys := XYs{
+		{X: 0.0, Y: 0.0},
+		{X: 1.0, Y: 0.0},
+		{X: 1.2, Y: 0.1},
+		{X: 2.0, Y: 1.0},
+		{X: 3.0, Y: 1.0},
+		{X: 3.1, Y: 3.0},
+		{X: 5.0, Y: 2.0},
+		{X: 6.0, Y: 2.0},
+	}
+
+	for name, limiter := range map[string]Limiter{"FritschCarlson": LimiterFritschCarlson, "Steffen": LimiterSteffen} {
+		limiter := limiter
+		t.Run(name, func(t *testing.T) {
+			interpolator, err := NewMonotoneCubic(xys, WithLimiter(limiter))
+			require.NoError(t, err)
+
+			for i := 1; i < len(xys); i++ {
+				lo, hi := xys[i-1].Y, xys[i].Y
+				if lo > hi {
+					lo, hi = hi, lo
+				}
+				previous := xys[i-1].Y
+				for _, x := range testGrid(xys[i-1].X, xys[i].X, 101) {
+					y := interpolator.Value(x)
+					assert.GreaterOrEqual(t, y, lo-1.0e-12)
+					assert.LessOrEqual(t, y, hi+1.0e-12)
+					assert.GreaterOrEqual(t, (y-previous)*(xys[i].Y-xys[i-1].Y), -1.0e-12)
+					previous = y
+				}
+			}
+
+			// The slope is zero at the local extremum and on the plateaus.
+			for _, x := range []float64{0.0, 1.0, 3.0, 3.1, 6.0} {
+				assert.InDelta(t, 0.0, interpolator.Gradient(x), 1.0e-12, "x=%v", x)
+			}
+		})
+	}
+}
+
+func TestMonotoneCubicContinuity(t *testing.T) {
+	const h = 1.0e-9
+
+	interpolator, err := NewMonotoneCubic(testExpXYs)
+	require.NoError(t, err)
+
+	for _, xy := range testExpXYs[1 : len(testExpXYs)-1] {
+		assert.InDelta(t, xy.Y, interpolator.Value(xy.X-h), 1.0e-6)
+		assert.InDelta(t, interpolator.Gradient(xy.X), interpolator.Gradient(xy.X-h), 1.0e-6)
+	}
+}
+
+func TestMonotoneCubicLinearXYs(t *testing.T) {
+	for _, limiter := range []Limiter{LimiterFritschCarlson, LimiterSteffen} {
+		interpolator, err := NewMonotoneCubic(testLinearXYs, WithLimiter(limiter), WithExtrapolation(ExtrapolateEdge()))
+		require.NoError(t, err)
+		for _, x := range []float64{-1.0, 0.3, 0.7, 1.2, 1.6, 3.0} {
+			assert.InDelta(t, testLinearFunc(x), interpolator.Value(x), 1.0e-12)
+		}
+	}
+}
+
+func BenchmarkMonotoneCubicValue(b *testing.B) {
+	interpolator, err := NewMonotoneCubic(testSquareXYs)
+	require.NoError(b, err)
+	var (
+		x = 0.5
+		y = 0.3125
+		v float64
+	)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		v = interpolator.Value(x)
+	}
+	b.StopTimer()
+	assert.InDelta(b, y, v, 1.0e-12)
+}
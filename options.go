@@ -0,0 +1,270 @@
+package interpolator
+
+import "fmt"
+
+// Option configures the construction of an interpolator.
+type Option func(*config)
+
+type config struct {
+	left  Extrapolation
+	right Extrapolation
+
+	skipValidation bool
+	prepareInput   bool
+	duplicates     DuplicatePolicy
+
+	search SearchStrategy
+	jumps  bool
+
+	leftBoundary  Boundary
+	rightBoundary Boundary
+
+	limiter Limiter
+	step    Step
+
+	weights   []float64
+	smoothing Smoothing
+
+	ameliorate bool
+
+	blending int
+
+	tolerance float64
+	maxDegree int
+}
+
+// newConfig returns the configuration resulting from the given options,
+// on top of the default extrapolation of the interpolator.
+func newConfig(extrapolation Extrapolation, opts []Option) *config {
+	cfg := &config{
+		left:      extrapolation,
+		right:     extrapolation,
+		blending:  defaultBlending,
+		tolerance: defaultTolerance,
+		maxDegree: defaultMaxDegree,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	return cfg
+}
+
+// prepare returns a copy of the input data of the interpolator called `name`,
+// prepared and validated according to the configuration, with optional additional checks.
+func (cfg *config) prepare(name string, xys XYs, checks ...func(XYs) error) (XYs, error) {
+	return cfg.prepareXYs(name, xys, false, checks)
+}
+
+// prepareJumps is the counterpart of prepare for the interpolators supporting jumps,
+// which are allowed in the input data if configured with WithJumps.
+func (cfg *config) prepareJumps(name string, xys XYs, checks ...func(XYs) error) (XYs, error) {
+	return cfg.prepareXYs(name, xys, cfg.jumps, checks)
+}
+
+func (cfg *config) prepareXYs(name string, xys XYs, jumps bool, checks []func(XYs) error) (XYs, error) {
+	if l := len(xys); l < 1 {
+		return nil, fmt.Errorf("%w: at least 1 point is required to build a %s interpolator, but got %d", ErrNotEnoughPoints, name, l)
+	}
+
+	switch {
+	case cfg.prepareInput && jumps:
+		// Keep the points sharing an abscissa, in their input order.
+		xys = xys.Copy()
+		xys.Sort()
+	case cfg.prepareInput:
+		prepared, err := xys.Prepare(cfg.duplicates)
+		if err != nil {
+			return nil, err
+		}
+		xys = prepared
+	default:
+		xys = xys.Copy()
+	}
+
+	if cfg.skipValidation {
+		return xys, nil
+	}
+
+	if err := xys.validate(jumps); err != nil {
+		return nil, err
+	}
+
+	for _, check := range checks {
+		if err := check(xys); err != nil {
+			return nil, err
+		}
+	}
+
+	return xys, nil
+}
+
+// prepareXYDs is the counterpart of prepare for data points with derivatives.
+func (cfg *config) prepareXYDs(name string, xyds XYDs) (XYDs, error) {
+	if l := len(xyds); l < 1 {
+		return nil, fmt.Errorf("%w: at least 1 point is required to build a %s interpolator, but got %d", ErrNotEnoughPoints, name, l)
+	}
+
+	if cfg.prepareInput {
+		return xyds.Prepare(cfg.duplicates)
+	}
+
+	xyds = xyds.Copy()
+	if cfg.skipValidation {
+		return xyds, nil
+	}
+
+	if err := xyds.Validate(); err != nil {
+		return nil, err
+	}
+
+	return xyds, nil
+}
+
+// WithExtrapolation sets the extrapolation on both sides of the domain.
+func WithExtrapolation(extrapolation Extrapolation) Option {
+	return func(cfg *config) {
+		cfg.left = extrapolation
+		cfg.right = extrapolation
+	}
+}
+
+// WithLeftExtrapolation sets the extrapolation below the smallest abscissa.
+func WithLeftExtrapolation(extrapolation Extrapolation) Option {
+	return func(cfg *config) {
+		cfg.left = extrapolation
+	}
+}
+
+// WithRightExtrapolation sets the extrapolation above the largest abscissa.
+func WithRightExtrapolation(extrapolation Extrapolation) Option {
+	return func(cfg *config) {
+		cfg.right = extrapolation
+	}
+}
+
+// WithoutValidation disables the validation of the input data,
+// which must then be known to be valid.
+func WithoutValidation() Option {
+	return func(cfg *config) {
+		cfg.skipValidation = true
+	}
+}
+
+// WithPrepare sorts the input data and merges the points sharing the same abscissa
+// according to the given policy, see XYs.Prepare.
+func WithPrepare(policy DuplicatePolicy) Option {
+	return func(cfg *config) {
+		cfg.prepareInput = true
+		cfg.duplicates = policy
+	}
+}
+
+// WithSearch sets the strategy used to find the segment containing an abscissa.
+// By default, the strategy is selected from the abscissas, see SearchAuto.
+func WithSearch(strategy SearchStrategy) Option {
+	return func(cfg *config) {
+		cfg.search = strategy
+	}
+}
+
+// WithJumps allows two consecutive data points to share an abscissa, which denotes a jump of the
+// interpolator from the ordinate of the first point to the ordinate of the second one.
+// The interpolator takes the ordinate of the second point at the jump, and is thus right-continuous.
+// Jumps are supported by PiecewiseLinear and Geometric, except at the first and last abscissas,
+// and more than two points sharing an abscissa yield a *PointError wrapping ErrDuplicateAbscissa.
+// With WithPrepare, the data points are sorted, but the points sharing an abscissa are kept in their input order.
+// The extrema of the interpolators account for the limits from the left at the jumps, see Range.
+func WithJumps() Option {
+	return func(cfg *config) {
+		cfg.jumps = true
+	}
+}
+
+// WithBoundary sets the boundary condition of splines at both ends of the domain.
+// By default, the boundary conditions are natural.
+func WithBoundary(boundary Boundary) Option {
+	return func(cfg *config) {
+		cfg.leftBoundary = boundary
+		cfg.rightBoundary = boundary
+	}
+}
+
+// WithLeftBoundary sets the boundary condition of splines at the smallest abscissa.
+func WithLeftBoundary(boundary Boundary) Option {
+	return func(cfg *config) {
+		cfg.leftBoundary = boundary
+	}
+}
+
+// WithRightBoundary sets the boundary condition of splines at the largest abscissa.
+func WithRightBoundary(boundary Boundary) Option {
+	return func(cfg *config) {
+		cfg.rightBoundary = boundary
+	}
+}
+
+// WithLimiter sets the limiter used to compute the slopes of monotone cubic interpolators.
+// By default, the Fritsch-Carlson limiter is used.
+func WithLimiter(limiter Limiter) Option {
+	return func(cfg *config) {
+		cfg.limiter = limiter
+	}
+}
+
+// WithStep sets the convention of piecewise constant interpolators for switching between data points.
+// By default, the interpolators are right-continuous, see StepRight.
+func WithStep(step Step) Option {
+	return func(cfg *config) {
+		cfg.step = step
+	}
+}
+
+// WithWeights sets the weights of the data points of smoothing splines, which must be positive,
+// in the order of the input data. By default, all the weights are 1.
+func WithWeights(weights []float64) Option {
+	return func(cfg *config) {
+		cfg.weights = weights
+	}
+}
+
+// WithSmoothing sets how the smoothing parameter of smoothing splines is chosen.
+// By default, it is selected by generalized cross-validation.
+func WithSmoothing(smoothing Smoothing) Option {
+	return func(cfg *config) {
+		cfg.smoothing = smoothing
+	}
+}
+
+// WithAmelioration makes monotone convex interpolators estimate the forward rates at both ends
+// like the inner ones, from discrete forward rates extended linearly beyond the edge intervals.
+func WithAmelioration() Option {
+	return func(cfg *config) {
+		cfg.ameliorate = true
+	}
+}
+
+// WithBlending sets the degree of the polynomials blended by barycentric rational interpolators,
+// which must be non-negative: the higher the degree, the faster the convergence for smooth data,
+// at the expense of the numerical stability. By default, the degree is 3.
+func WithBlending(degree int) Option {
+	return func(cfg *config) {
+		cfg.blending = degree
+	}
+}
+
+// WithTolerance sets the tolerance of Chebyshev approximations, relative to the largest absolute value
+// of the approximated function at the sampled points, which must be positive. By default, it is 1e-13.
+func WithTolerance(tolerance float64) Option {
+	return func(cfg *config) {
+		cfg.tolerance = tolerance
+	}
+}
+
+// WithMaxDegree sets the maximum degree of Chebyshev approximations, which bounds the number of evaluations
+// of the approximated function, and must be positive. By default, it is 4096.
+func WithMaxDegree(degree int) Option {
+	return func(cfg *config) {
+		cfg.maxDegree = degree
+	}
+}
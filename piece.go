@@ -0,0 +1,197 @@
+package interpolator
+
+import "math"
+
+// piece is the interpolation law on a single segment between two data points,
+// or on an extrapolation region.
+type piece interface {
+	value(x float64) float64
+	gradient(x float64) float64
+	secondDerivative(x float64) float64
+	// integral computes the integral of the law between a and b, with a <= b.
+	integral(a, b float64) float64
+	// crossings appends the crossings of the level inside [u, v], with u < v,
+	// given the values fu and fv of the law at u and v.
+	// The crossings at u and v which are not part of a plateau can be omitted.
+	crossings(level, u, v, fu, fv float64, dst []Crossing) []Crossing
+	// extrema returns the points where the law reaches its minimum and maximum on [u, v], with u < v,
+	// given the values fu and fv of the law at u and v.
+	extrema(u, v, fu, fv float64) (XY, XY)
+}
+
+// constantPiece is a constant law.
+type constantPiece float64
+
+func (p constantPiece) value(float64) float64 {
+	return float64(p)
+}
+
+func (p constantPiece) gradient(float64) float64 {
+	return 0.0
+}
+
+func (p constantPiece) secondDerivative(float64) float64 {
+	return 0.0
+}
+
+func (p constantPiece) integral(a, b float64) float64 {
+	return float64(p) * (b - a)
+}
+
+func (p constantPiece) extrema(u, _, _, _ float64) (XY, XY) {
+	xy := XY{X: u, Y: float64(p)}
+
+	return xy, xy
+}
+
+func (p constantPiece) crossings(level, u, v, _, _ float64, dst []Crossing) []Crossing {
+	if float64(p) == level {
+		return append(dst, Crossing{From: u, To: v})
+	}
+
+	return dst
+}
+
+// nanPiece is an undefined law.
+type nanPiece struct{}
+
+func (nanPiece) value(float64) float64 {
+	return math.NaN()
+}
+
+func (nanPiece) gradient(float64) float64 {
+	return math.NaN()
+}
+
+func (nanPiece) secondDerivative(float64) float64 {
+	return math.NaN()
+}
+
+func (nanPiece) integral(float64, float64) float64 {
+	return math.NaN()
+}
+
+func (nanPiece) extrema(float64, float64, float64, float64) (XY, XY) {
+	return emptyRange()
+}
+
+func (nanPiece) crossings(_, _, _, _, _ float64, dst []Crossing) []Crossing {
+	return dst
+}
+
+// splitPiece is the law following `left` below the abscissa `at`, and `right` from it.
+type splitPiece struct {
+	at    float64
+	left  piece
+	right piece
+}
+
+func (p splitPiece) side(x float64) piece {
+	if x < p.at {
+		return p.left
+	}
+
+	return p.right
+}
+
+func (p splitPiece) value(x float64) float64 {
+	return p.side(x).value(x)
+}
+
+func (p splitPiece) gradient(x float64) float64 {
+	return p.side(x).gradient(x)
+}
+
+func (p splitPiece) secondDerivative(x float64) float64 {
+	return p.side(x).secondDerivative(x)
+}
+
+func (p splitPiece) integral(a, b float64) float64 {
+	switch {
+	case b <= p.at:
+		return p.left.integral(a, b)
+	case a >= p.at:
+		return p.right.integral(a, b)
+	}
+
+	return p.left.integral(a, p.at) + p.right.integral(p.at, b)
+}
+
+func (p splitPiece) crossings(level, u, v, fu, fv float64, dst []Crossing) []Crossing {
+	switch {
+	case v <= p.at:
+		return p.left.crossings(level, u, v, fu, fv, dst)
+	case u >= p.at:
+		return p.right.crossings(level, u, v, fu, fv, dst)
+	}
+
+	fm := p.right.value(p.at)
+	dst = p.left.crossings(level, u, p.at, fu, fm, dst)
+	if fm == level {
+		dst = append(dst, Crossing{From: p.at, To: p.at})
+	}
+
+	return p.right.crossings(level, p.at, v, fm, fv, dst)
+}
+
+func (p splitPiece) extrema(u, v, fu, fv float64) (XY, XY) {
+	switch {
+	case v <= p.at:
+		return p.left.extrema(u, v, fu, fv)
+	case u >= p.at:
+		return p.right.extrema(u, v, fu, fv)
+	}
+
+	fm := p.right.value(p.at)
+	leftLo, leftHi := p.left.extrema(u, p.at, fu, fm)
+	rightLo, rightHi := p.right.extrema(p.at, v, fm, fv)
+
+	return lower(leftLo, rightLo), higher(leftHi, rightHi)
+}
+
+// jumpPiece is the law of the zero-width segment between two data points sharing the abscissa x,
+// where the curve jumps to the ordinate y of the second point.
+type jumpPiece XY
+
+func (p jumpPiece) value(float64) float64 {
+	return p.Y
+}
+
+func (jumpPiece) gradient(float64) float64 {
+	return 0.0
+}
+
+func (jumpPiece) secondDerivative(float64) float64 {
+	return 0.0
+}
+
+func (jumpPiece) integral(float64, float64) float64 {
+	return 0.0
+}
+
+// extrema reports the ordinate after the jump, which is the value of the curve at x.
+func (p jumpPiece) extrema(float64, float64, float64, float64) (XY, XY) {
+	return XY(p), XY(p)
+}
+
+// crossings reports nothing, the jump being detected as a discontinuity between the adjacent segments.
+func (jumpPiece) crossings(_, _, _, _, _ float64, dst []Crossing) []Crossing {
+	return dst
+}
+
+func (p jumpPiece) inverse(float64) float64 {
+	return p.X
+}
+
+// flatPiece is a law whose derivatives are 0 by convention, where it is not differentiable.
+type flatPiece struct {
+	piece
+}
+
+func (flatPiece) gradient(float64) float64 {
+	return 0.0
+}
+
+func (flatPiece) secondDerivative(float64) float64 {
+	return 0.0
+}
@@ -1,40 +1,176 @@
 package interpolator
 
-import "errors"
+import (
+	"fmt"
+	"math"
+)
 
-// PiecewiseConstant is a classic piecewise constant cadlag interpolator.
+// Step defines where a piecewise constant interpolator switches from the ordinate of a data point
+// to the ordinate of the next one, and which of both it takes at the switch.
+type Step int
+
+const (
+	// StepRight switches at the abscissa of the next data point, which takes its own ordinate:
+	// the interpolator is right-continuous (càdlàg).
+	StepRight Step = iota
+	// StepLeft switches right after the abscissa of the data point, which keeps its own ordinate:
+	// the interpolator is left-continuous (càglàd).
+	StepLeft
+	// StepNearest takes the ordinate of the nearest data point, or of the previous one at equal distance:
+	// the interpolator switches at the middle of the segments, where it is left-continuous.
+	StepNearest
+	// StepMidpoint switches at the middle of the segments, which takes the ordinate of the next data point:
+	// the interpolator is right-continuous.
+	StepMidpoint
+)
+
+// PiecewiseConstant is a classic piecewise constant interpolator, cadlag by default.
 type PiecewiseConstant struct {
-	xys XYs
+	*curve
 }
 
 // NewPiecewiseConstant builds a piecewise constant interpolator.
-// The input `xys` must be ordered and have unique abscissas.
-func NewPiecewiseConstant(xys XYs) (*PiecewiseConstant, error) {
-	if len(xys) < 1 {
-		return nil, errors.New("at least 1 point is required to build a piecewise constant interpolator, but got 0")
+// The input `xys` must be ordered and have unique abscissas,
+// otherwise a *PointError is returned.
+// By default, the interpolator is right-continuous, see WithStep, and the extrapolation is flat.
+func NewPiecewiseConstant(xys XYs, opts ...Option) (*PiecewiseConstant, error) {
+	cfg := newConfig(ExtrapolateFlat(), opts)
+
+	xys, err := cfg.prepare("piecewise constant", xys)
+	if err != nil {
+		return nil, err
+	}
+
+	law, err := stepLaw(cfg.step)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := newCurve(xys, cfg, law)
+	if err != nil {
+		return nil, err
 	}
 
 	return &PiecewiseConstant{
-		xys: xys,
+		curve: c,
 	}, nil
 }
 
 // Value compute the value of f(x) based on piecewise constant interpolation.
 func (interp PiecewiseConstant) Value(x float64) float64 {
-	if n := len(interp.xys); n == 1 {
-		// In case a single data point is provided, assume a constant curve
-		return interp.xys[n-1].Y
+	return interp.value(x)
+}
+
+// Gradient computes the gradient of f(x) based on piecewise constant interpolation.
+func (interp PiecewiseConstant) Gradient(x float64) float64 {
+	return interp.gradient(x)
+}
+
+// SecondDerivative computes the second derivative of f(x) based on piecewise constant interpolation, which is zero.
+func (interp PiecewiseConstant) SecondDerivative(x float64) float64 {
+	return interp.secondDerivative(x)
+}
+
+// stepLaw returns the interpolation law between two points following the step convention.
+func stepLaw(step Step) (func(p1, p2 XY) piece, error) {
+	switch step {
+	case StepRight:
+		return func(p1, p2 XY) piece {
+			return stepPiece{p1: p1, p2: p2, at: p2.X}
+		}, nil
+	case StepLeft:
+		return func(p1, p2 XY) piece {
+			return stepPiece{p1: p1, p2: p2, at: p1.X, leftContinuous: true}
+		}, nil
+	case StepNearest:
+		return func(p1, p2 XY) piece {
+			return stepPiece{p1: p1, p2: p2, at: 0.5 * (p1.X + p2.X), leftContinuous: true}
+		}, nil
+	case StepMidpoint:
+		return func(p1, p2 XY) piece {
+			return stepPiece{p1: p1, p2: p2, at: 0.5 * (p1.X + p2.X)}
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown step %d", step)
 	}
+}
 
-	p1, p2 := interp.xys.Interval(x)
-	if x < p2.X {
-		return p1.Y
+// stepPiece is the step law between two points:
+// it takes the ordinate of the first point before the abscissa `at`, and the ordinate of the second one after.
+// At `at`, it takes the ordinate of the first point if it is left-continuous, and of the second one otherwise.
+type stepPiece struct {
+	p1             XY
+	p2             XY
+	at             float64
+	leftContinuous bool
+}
+
+func (p stepPiece) value(x float64) float64 {
+	if x < p.at || (x == p.at && p.leftContinuous) {
+		return p.p1.Y
 	}
 
-	return p2.Y
+	return p.p2.Y
 }
 
-// Gradient computes the gradient of f(x) based on piecewise constant interpolation.
-func (interp PiecewiseConstant) Gradient(float64) float64 {
+func (p stepPiece) gradient(float64) float64 {
+	return 0.0
+}
+
+func (p stepPiece) secondDerivative(float64) float64 {
 	return 0.0
 }
+
+// integral does not depend on the value at the step, which has no width.
+func (p stepPiece) integral(a, b float64) float64 {
+	return p.p1.Y*(math.Min(b, p.at)-math.Min(a, p.at)) + p.p2.Y*(math.Max(b, p.at)-math.Max(a, p.at))
+}
+
+// crossings reports the plateaus on each side of the step, and the step itself
+// when it jumps across the level. The step belongs to [u, v] if the ordinate it jumps to
+// is reached inside, so that adjacent intervals do not both report it.
+func (p stepPiece) crossings(level, u, v, _, _ float64, dst []Crossing) []Crossing {
+	x := p.at
+	if u < x && p.p1.Y == level {
+		dst = append(dst, Crossing{From: u, To: min(v, x)})
+	}
+	if p.jumpsWithin(u, v) && (p.p1.Y-level)*(p.p2.Y-level) < 0.0 {
+		dst = append(dst, Crossing{From: x, To: x})
+	}
+	if v > x && p.p2.Y == level {
+		dst = append(dst, Crossing{From: max(u, x), To: v})
+	}
+
+	return dst
+}
+
+// jumpsWithin reports whether the step at `at` belongs to [u, v].
+func (p stepPiece) jumpsWithin(u, v float64) bool {
+	if p.leftContinuous {
+		return u <= p.at && p.at < v
+	}
+
+	return u < p.at && p.at <= v
+}
+
+func (p stepPiece) extrema(u, v, _, _ float64) (XY, XY) {
+	lo, hi := emptyRange()
+	if x := p.at; u < x || (u == x && p.leftContinuous) {
+		xy := XY{X: u, Y: p.p1.Y}
+		lo, hi = lower(lo, xy), higher(hi, xy)
+	}
+	switch x := p.at; {
+	case v > x && p.leftContinuous:
+		// The ordinate of the second point is only reached after the step.
+		xy := XY{X: u, Y: p.p2.Y}
+		if u <= x {
+			xy.X = v
+		}
+		lo, hi = lower(lo, xy), higher(hi, xy)
+	case v >= x && !p.leftContinuous:
+		xy := XY{X: max(u, x), Y: p.p2.Y}
+		lo, hi = lower(lo, xy), higher(hi, xy)
+	}
+
+	return lo, hi
+}
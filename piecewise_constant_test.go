@@ -15,7 +15,21 @@ func TestNewPiecewiseConstant(t *testing.T) {
 
 func TestNewPiecewiseConstantEmptyXYs(t *testing.T) {
 	_, err := NewPiecewiseConstant(XYs{})
-	require.Error(t, err)
+	require.ErrorIs(t, err, ErrNotEnoughPoints)
+}
+
+func TestNewPiecewiseConstantUnsortedXYs(t *testing.T) {
+	_, err := NewPiecewiseConstant(XYs{
+		{
+			X: 1.0,
+			Y: 1.0,
+		},
+		{
+			X: 0.0,
+			Y: 1.0,
+		},
+	})
+	require.ErrorIs(t, err, ErrUnsorted)
 }
 
 func TestPiecewiseConstantValue_SinglePoint(t *testing.T) {
@@ -87,6 +101,99 @@ func TestPiecewiseConstantValue(t *testing.T) {
 	}
 }
 
+func TestNewPiecewiseConstantUnknownStep(t *testing.T) {
+	_, err := NewPiecewiseConstant(testLinearXYs, WithStep(Step(-1)))
+	require.Error(t, err)
+}
+
+func TestPiecewiseConstantSteps(t *testing.T) {
+	xys := XYs{
+		{
+			X: 0.0,
+			Y: 1.0,
+		},
+		{
+			X: 1.0,
+			Y: 3.0,
+		},
+		{
+			X: 2.0,
+			Y: 2.0,
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		step     Step
+		inputs   []float64
+		expected []float64
+		integral float64
+	}{
+		{
+			"Right",
+			StepRight,
+			[]float64{-1.0, 0.0, 0.5, 1.0, 1.5, 2.0, 3.0},
+			[]float64{1.0, 1.0, 1.0, 3.0, 3.0, 2.0, 2.0},
+			4.0,
+		},
+		{
+			"Left",
+			StepLeft,
+			[]float64{-1.0, 0.0, 0.5, 1.0, 1.5, 2.0, 3.0},
+			[]float64{1.0, 1.0, 3.0, 3.0, 2.0, 2.0, 2.0},
+			5.0,
+		},
+		{
+			"Nearest",
+			StepNearest,
+			[]float64{-1.0, 0.0, 0.4, 0.5, 0.6, 1.0, 1.5, 1.6, 2.0, 3.0},
+			[]float64{1.0, 1.0, 1.0, 1.0, 3.0, 3.0, 3.0, 2.0, 2.0, 2.0},
+			4.5,
+		},
+		{
+			"Midpoint",
+			StepMidpoint,
+			[]float64{-1.0, 0.0, 0.4, 0.5, 0.6, 1.0, 1.5, 1.6, 2.0, 3.0},
+			[]float64{1.0, 1.0, 1.0, 3.0, 3.0, 3.0, 2.0, 2.0, 2.0, 2.0},
+			4.5,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			interpolator, err := NewPiecewiseConstant(xys, WithStep(tc.step))
+			require.NoError(t, err)
+
+			values := make([]float64, len(tc.inputs))
+			interpolator.ValuesInto(values, tc.inputs)
+			for i, x := range tc.inputs {
+				assert.Equal(t, tc.expected[i], interpolator.Value(x), "x=%v", x)
+				assert.Equal(t, tc.expected[i], values[i], "x=%v", x)
+				assert.Equal(t, 0.0, interpolator.Gradient(x), "x=%v", x)
+				assert.Equal(t, 0.0, interpolator.SecondDerivative(x), "x=%v", x)
+			}
+
+			// The data points are always interpolated exactly.
+			for _, xy := range xys {
+				assert.Equal(t, xy.Y, interpolator.Value(xy.X))
+			}
+
+			assert.InDelta(t, tc.integral, interpolator.Integral(0.0, 2.0), 1.0e-12)
+			assert.InDelta(t, tc.integral+3.0, interpolator.Integral(-1.0, 3.0), 1.0e-12)
+			assert.InDelta(t, tc.integral+3.0, interpolator.Antiderivative().Value(3.0)-interpolator.Antiderivative().Value(-1.0), 1.0e-12)
+		})
+	}
+}
+
+func TestPiecewiseConstantStepEdgeExtrapolation(t *testing.T) {
+	for _, step := range []Step{StepRight, StepLeft, StepNearest, StepMidpoint} {
+		interpolator, err := NewPiecewiseConstant(testLinearXYs, WithStep(step), WithExtrapolation(ExtrapolateEdge()))
+		require.NoError(t, err)
+		assert.Equal(t, testLinearXYs[0].Y, interpolator.Value(-1.0), "step=%v", step)
+		assert.Equal(t, testLinearXYs[len(testLinearXYs)-1].Y, interpolator.Value(6.0), "step=%v", step)
+	}
+}
+
 func ExamplePiecewiseConstant_Value() {
 	xys := XYs{
 		{
@@ -174,3 +281,19 @@ func ExamplePiecewiseConstant_Gradient() {
 	fmt.Println(interp.Gradient(0.75))
 	// Output: 0
 }
+
+func BenchmarkPiecewiseConstantValue(b *testing.B) {
+	interpolator, err := NewPiecewiseConstant(testLinearXYs)
+	require.NoError(b, err)
+	var (
+		x = 0.7
+		y = testLinearFunc(0.5)
+		v float64
+	)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		v = interpolator.Value(x)
+	}
+	b.StopTimer()
+	assert.InDelta(b, y, v, 1.0e-8)
+}
@@ -1,44 +1,109 @@
 package interpolator
 
-import "fmt"
+import "math"
 
 // PiecewiseLinear is a classic piecewise linear interpolator.
 type PiecewiseLinear struct {
-	xys XYs
+	*curve
 }
 
 // NewPiecewiseLinear builds a piecewise linear interpolator.
-func NewPiecewiseLinear(xys XYs) (*PiecewiseLinear, error) {
-	if l := len(xys); l < 1 {
-		return nil, fmt.Errorf("at least 1 points is required to build a piecewise linear interpolator, but got %d", l)
+// The input `xys` must be ordered and have unique abscissas,
+// otherwise a *PointError is returned.
+// Jumps can be denoted by pairs of points sharing an abscissa, see WithJumps:
+// at a jump, the interpolator takes the ordinate of the second point.
+// By default, the edge segments are extended for extrapolation.
+func NewPiecewiseLinear(xys XYs, opts ...Option) (*PiecewiseLinear, error) {
+	cfg := newConfig(ExtrapolateEdge(), opts)
+
+	xys, err := cfg.prepareJumps("piecewise linear", xys)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := newCurve(xys, cfg, newLinearPiece)
+	if err != nil {
+		return nil, err
 	}
 
 	return &PiecewiseLinear{
-		xys: xys,
+		curve: c,
 	}, nil
 }
 
 // Value compute the value of f(x) based on piecewise linear interpolation.
 func (interp PiecewiseLinear) Value(x float64) float64 {
-	if n := len(interp.xys); n == 1 {
-		// In case a single data point is provided, assume a constant curve
-		return interp.xys[0].Y
-	}
+	return interp.value(x)
+}
 
-	p1, p2 := interp.xys.Interval(x)
-	lambda := (x - p1.X) / (p2.X - p1.X)
+// ValueLeft computes the limit of f at x from the left, which is f(x) except at the jumps,
+// where it is the ordinate of the first of the data points sharing the abscissa x.
+func (interp PiecewiseLinear) ValueLeft(x float64) float64 {
+	return interp.valueLeft(x)
+}
 
-	return p1.Y*(1.0-lambda) + p2.Y*lambda
+// ValueRight computes the limit of f at x from the right, which is f(x):
+// at the jumps, f takes the ordinate of the second of the data points sharing the abscissa x.
+func (interp PiecewiseLinear) ValueRight(x float64) float64 {
+	return interp.value(x)
 }
 
 // Gradient computes the gradient of f(x) based on linear interpolation.
 func (interp PiecewiseLinear) Gradient(x float64) float64 {
-	if n := len(interp.xys); n == 1 {
-		// In case a single data point is provided, assume a constant curve
-		return 0.0
+	return interp.gradient(x)
+}
+
+// SecondDerivative computes the second derivative of f(x) based on linear interpolation, which is zero.
+func (interp PiecewiseLinear) SecondDerivative(x float64) float64 {
+	return interp.secondDerivative(x)
+}
+
+// Inverse returns the abscissa x such that f(x) = y based on piecewise linear interpolation.
+// The input data must have strictly monotone ordinates, otherwise an error wrapping ErrNotMonotone
+// is returned, and y must lie between the first and last ordinates, otherwise ErrOutOfRange is wrapped.
+func (interp PiecewiseLinear) Inverse(y float64) (float64, error) {
+	return interp.inverse(y)
+}
+
+// linearPiece is the linear law going through (x0, y0) with a given slope.
+type linearPiece struct {
+	x0    float64
+	y0    float64
+	slope float64
+}
+
+func newLinearPiece(p1, p2 XY) piece {
+	return linearPiece{
+		x0:    p1.X,
+		y0:    p1.Y,
+		slope: (p2.Y - p1.Y) / (p2.X - p1.X),
 	}
+}
+
+func (p linearPiece) value(x float64) float64 {
+	return math.FMA(p.slope, x-p.x0, p.y0)
+}
 
-	p1, p2 := interp.xys.Interval(x)
+func (p linearPiece) gradient(float64) float64 {
+	return p.slope
+}
+
+func (p linearPiece) secondDerivative(float64) float64 {
+	return 0.0
+}
+
+func (p linearPiece) integral(a, b float64) float64 {
+	return 0.5 * (b - a) * (p.value(a) + p.value(b))
+}
+
+func (p linearPiece) inverse(y float64) float64 {
+	return p.x0 + (y-p.y0)/p.slope
+}
+
+func (p linearPiece) crossings(level, u, v, fu, fv float64, dst []Crossing) []Crossing {
+	return monotoneCrossings(p, level, u, v, fu, fv, dst)
+}
 
-	return (p2.Y - p1.Y) / (p2.X - p1.X)
+func (p linearPiece) extrema(u, v, fu, fv float64) (XY, XY) {
+	return monotoneExtrema(u, v, fu, fv)
 }
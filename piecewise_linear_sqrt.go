@@ -1,58 +1,123 @@
 package interpolator
 
-import (
-	"fmt"
-	"math"
-)
+import "math"
 
 // PiecewiseLinearSqrt performs a piecewise linear interpolation with respect to the square root of the abscissae.
 type PiecewiseLinearSqrt struct {
-	xys XYs
+	*curve
 }
 
 // NewPiecewiseLinearSqrt builds a piecewise linear sqrt interpolator with flat extrapolation.
-// The input `xys` must be ordered and have unique abscissas.
-func NewPiecewiseLinearSqrt(xys XYs) (*PiecewiseLinearSqrt, error) {
-	if l := len(xys); l < 1 {
-		return nil, fmt.Errorf("at least 1 points is required to build a piecewise linear sqrt interpolator, but got %d", l)
+// The input `xys` must be ordered and have unique abscissas,
+// otherwise a *PointError is returned.
+func NewPiecewiseLinearSqrt(xys XYs, opts ...Option) (*PiecewiseLinearSqrt, error) {
+	cfg := newConfig(ExtrapolateFlat(), opts)
+
+	xys, err := cfg.prepare("piecewise linear sqrt", xys)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := newCurve(xys, cfg, newLinearSqrtPiece)
+	if err != nil {
+		return nil, err
 	}
+	c.flatEnd()
 
 	return &PiecewiseLinearSqrt{
-		xys: xys,
+		curve: c,
 	}, nil
 }
 
 // Value compute the value of f(x) based on piecewise linear interpolation with flat extrapolation.
 func (interp PiecewiseLinearSqrt) Value(x float64) float64 {
-	if n := len(interp.xys); n == 1 {
-		// In case a single data point is provided, assume a constant curve
-		return interp.xys[0].Y
-	}
+	return interp.value(x)
+}
 
-	p1, p2 := interp.xys.Interval(x)
-	if x <= p1.X {
-		return p1.Y
-	}
-	if x >= p2.X {
-		return p2.Y
-	}
+// Gradient computes the gradient of f(x) based on piecewise linear interpolation with flat extrapolation.
+// The gradient is infinite at the first point of each segment, so that 0 is returned at the data points
+// by convention, including the last one. ExtrapolateTangent follows the last segment with its derivative.
+func (interp PiecewiseLinearSqrt) Gradient(x float64) float64 {
+	return interp.gradient(x)
+}
 
-	lambda := math.Sqrt((x - p1.X) / (p2.X - p1.X))
+// SecondDerivative computes the second derivative of f(x) based on piecewise linear sqrt interpolation.
+// As the gradient, it is 0 at the data points by convention.
+func (interp PiecewiseLinearSqrt) SecondDerivative(x float64) float64 {
+	return interp.secondDerivative(x)
+}
 
-	return p1.Y*(1.0-lambda) + p2.Y*lambda
+// Inverse returns the abscissa x such that f(x) = y based on piecewise linear sqrt interpolation.
+// The input data must have strictly monotone ordinates, otherwise an error wrapping ErrNotMonotone
+// is returned, and y must lie between the first and last ordinates, otherwise ErrOutOfRange is wrapped.
+func (interp PiecewiseLinearSqrt) Inverse(y float64) (float64, error) {
+	return interp.inverse(y)
 }
 
-// Gradient computes the gradient of f(x) based on piecewise linear interpolation with flat extrapolation.
-func (interp PiecewiseLinearSqrt) Gradient(x float64) float64 {
-	if n := len(interp.xys); n == 1 {
-		// In case a single data point is provided, assume a constant curve
+// linearSqrtPiece is the linear law between two points
+// with respect to the square root of the normalized distance from the first point.
+type linearSqrtPiece struct {
+	x0       float64
+	y0       float64
+	dy       float64
+	invWidth float64
+	// halfSlope is the factor of 1/sqrt(x-x0) in the gradient.
+	halfSlope float64
+}
+
+func newLinearSqrtPiece(p1, p2 XY) piece {
+	invWidth := 1.0 / (p2.X - p1.X)
+	dy := p2.Y - p1.Y
+
+	return linearSqrtPiece{
+		x0:        p1.X,
+		y0:        p1.Y,
+		dy:        dy,
+		invWidth:  invWidth,
+		halfSlope: 0.5 * dy * math.Sqrt(invWidth),
+	}
+}
+
+func (p linearSqrtPiece) value(x float64) float64 {
+	return math.FMA(p.dy, math.Sqrt((x-p.x0)*p.invWidth), p.y0)
+}
+
+// gradient is infinite at the first point of the segment, where 0 is returned by convention.
+func (p linearSqrtPiece) gradient(x float64) float64 {
+	if x <= p.x0 {
 		return 0.0
 	}
 
-	p1, p2 := interp.xys.Interval(x)
-	if x <= p1.X || x >= p2.X {
+	return p.halfSlope / math.Sqrt(x-p.x0)
+}
+
+// secondDerivative is infinite at the first point of the segment, where 0 is returned by convention.
+func (p linearSqrtPiece) secondDerivative(x float64) float64 {
+	if x <= p.x0 {
 		return 0.0
 	}
 
-	return 0.5 * (p2.Y - p1.Y) / math.Sqrt((p2.X-p1.X)*(x-p1.X))
+	h := x - p.x0
+
+	return -0.5 * p.halfSlope / (h * math.Sqrt(h))
+}
+
+func (p linearSqrtPiece) integral(a, b float64) float64 {
+	ha, hb := a-p.x0, b-p.x0
+
+	return p.y0*(b-a) + 2.0/3.0*p.dy*math.Sqrt(p.invWidth)*(hb*math.Sqrt(hb)-ha*math.Sqrt(ha))
+}
+
+func (p linearSqrtPiece) inverse(y float64) float64 {
+	lambda := (y - p.y0) / p.dy
+
+	return p.x0 + lambda*lambda/p.invWidth
+}
+
+func (p linearSqrtPiece) crossings(level, u, v, fu, fv float64, dst []Crossing) []Crossing {
+	return monotoneCrossings(p, level, u, v, fu, fv, dst)
+}
+
+func (p linearSqrtPiece) extrema(u, v, fu, fv float64) (XY, XY) {
+	return monotoneExtrema(u, v, fu, fv)
 }
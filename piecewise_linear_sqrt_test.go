@@ -39,7 +39,21 @@ func TestNewPiecewiseLinearSqrt(t *testing.T) {
 
 func TestNewPiecewiseLinearSqrtEmptyXYs(t *testing.T) {
 	_, err := NewPiecewiseLinearSqrt(XYs{})
-	require.Error(t, err)
+	require.ErrorIs(t, err, ErrNotEnoughPoints)
+}
+
+func TestNewPiecewiseLinearSqrtUnsortedXYs(t *testing.T) {
+	_, err := NewPiecewiseLinearSqrt(XYs{
+		{
+			X: 1.0,
+			Y: 1.0,
+		},
+		{
+			X: 0.0,
+			Y: 1.0,
+		},
+	})
+	require.ErrorIs(t, err, ErrUnsorted)
 }
 
 func TestNewPiecewiseLinearSqrtSinglePoint(t *testing.T) {
@@ -202,6 +216,23 @@ func TestPiecewiseLinearSqrtGradientLinearXYs(t *testing.T) {
 	}
 }
 
+func TestPiecewiseLinearSqrtGradientAtDataPoints(t *testing.T) {
+	interpolator, err := NewPiecewiseLinearSqrt(XYs{{X: 0.0, Y: 1.0}, {X: 1.0, Y: 2.0}, {X: 2.0, Y: 4.0}}, WithExtrapolation(ExtrapolateEdge()))
+	require.NoError(t, err)
+
+	for _, x := range []float64{0.0, 1.0, 2.0} {
+		assert.Zero(t, interpolator.Gradient(x), "x=%v", x)
+	}
+
+	// The convention only applies at the data points, not to the extrapolation.
+	assert.InDelta(t, 1.0, interpolator.Gradient(2.0+1.0e-12), 1.0e-6)
+
+	gradients := make([]float64, 2)
+	interpolator.GradientsInto(gradients, []float64{1.5, 2.0})
+	assert.Zero(t, gradients[1])
+	assert.Zero(t, NewEvaluator(interpolator).Gradient(2.0))
+}
+
 func ExamplePiecewiseLinearSqrt_Gradient() {
 	xys := XYs{
 		{
@@ -224,3 +255,35 @@ func ExamplePiecewiseLinearSqrt_Gradient() {
 	fmt.Println(interp.Gradient(1.5))
 	// Output: 0
 }
+
+func BenchmarkPiecewiseLinearSqrtValue(b *testing.B) {
+	interpolator, err := NewPiecewiseLinearSqrt(testLinearXYs)
+	require.NoError(b, err)
+	var (
+		x = 0.7
+		y = testLinearFunc(0.5) + 0.6*math.Sqrt(0.4)
+		v float64
+	)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		v = interpolator.Value(x)
+	}
+	b.StopTimer()
+	assert.InDelta(b, y, v, 1.0e-8)
+}
+
+func BenchmarkPiecewiseLinearSqrtDerivative(b *testing.B) {
+	interpolator, err := NewPiecewiseLinearSqrt(testLinearXYs)
+	require.NoError(b, err)
+	var (
+		x = 0.7
+		y = 0.5 * 1.2 * 0.5 / math.Sqrt(0.2*0.5)
+		v float64
+	)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		v = interpolator.Gradient(x)
+	}
+	b.StopTimer()
+	assert.InDelta(b, y, v, 1.0e-8)
+}
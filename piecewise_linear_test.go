@@ -15,7 +15,21 @@ func TestNewPiecewiseLinear(t *testing.T) {
 
 func TestNewPiecewiseLinearEmptyXYs(t *testing.T) {
 	_, err := NewPiecewiseLinear(XYs{})
-	require.Error(t, err)
+	require.ErrorIs(t, err, ErrNotEnoughPoints)
+}
+
+func TestNewPiecewiseLinearUnsortedXYs(t *testing.T) {
+	_, err := NewPiecewiseLinear(XYs{
+		{
+			X: 1.0,
+			Y: 1.0,
+		},
+		{
+			X: 0.0,
+			Y: 1.0,
+		},
+	})
+	require.ErrorIs(t, err, ErrUnsorted)
 }
 
 func TestNewPiecewiseLinearSinglePoint(t *testing.T) {
@@ -87,6 +101,186 @@ func TestPiecewiseLinearValue(t *testing.T) {
 	}
 }
 
+// testJumpXYs jump from 1 to 3 at 1.
+var testJumpXYs = XYs{
+	{
+		X: 0.0,
+		Y: 0.0,
+	},
+	{
+		X: 1.0,
+		Y: 1.0,
+	},
+	{
+		X: 1.0,
+		Y: 3.0,
+	},
+	{
+		X: 2.0,
+		Y: 4.0,
+	},
+}
+
+func TestNewPiecewiseLinearJumpsInvalid(t *testing.T) {
+	testCases := []struct {
+		name  string
+		xys   XYs
+		opts  []Option
+		index int
+	}{
+		{
+			"WithoutJumps",
+			testJumpXYs,
+			nil,
+			2,
+		},
+		{
+			"FirstAbscissa",
+			XYs{{X: 0.0, Y: 0.0}, {X: 0.0, Y: 1.0}, {X: 1.0, Y: 1.0}},
+			[]Option{WithJumps()},
+			1,
+		},
+		{
+			"LastAbscissa",
+			XYs{{X: 0.0, Y: 0.0}, {X: 1.0, Y: 1.0}, {X: 1.0, Y: 2.0}},
+			[]Option{WithJumps()},
+			2,
+		},
+		{
+			"ThreePoints",
+			XYs{{X: 0.0, Y: 0.0}, {X: 1.0, Y: 1.0}, {X: 1.0, Y: 2.0}, {X: 1.0, Y: 3.0}, {X: 2.0, Y: 3.0}},
+			[]Option{WithJumps()},
+			3,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewPiecewiseLinear(tc.xys, tc.opts...)
+			require.ErrorIs(t, err, ErrDuplicateAbscissa)
+
+			var pointErr *PointError
+			require.ErrorAs(t, err, &pointErr)
+			assert.Equal(t, tc.index, pointErr.Index)
+		})
+	}
+}
+
+func TestNewJumpsUnsupported(t *testing.T) {
+	_, err := NewCubicSpline(testJumpXYs, WithJumps())
+	require.ErrorIs(t, err, ErrDuplicateAbscissa)
+}
+
+func TestPiecewiseLinearJumps(t *testing.T) {
+	const tol = 1.0e-12
+
+	interpolator, err := NewPiecewiseLinear(testJumpXYs, WithJumps())
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		input    float64
+		expected float64
+		left     float64
+		gradient float64
+	}{
+		{
+			"LeftExtrapolation",
+			-1.0,
+			-1.0,
+			-1.0,
+			1.0,
+		},
+		{
+			"BeforeJump",
+			0.5,
+			0.5,
+			0.5,
+			1.0,
+		},
+		{
+			"Jump",
+			1.0,
+			3.0,
+			1.0,
+			1.0,
+		},
+		{
+			"AfterJump",
+			1.5,
+			3.5,
+			3.5,
+			1.0,
+		},
+		{
+			"LastPoint",
+			2.0,
+			4.0,
+			4.0,
+			1.0,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			assert.InDelta(t, tc.expected, interpolator.Value(tc.input), tol)
+			assert.InDelta(t, tc.expected, interpolator.ValueRight(tc.input), tol)
+			assert.InDelta(t, tc.left, interpolator.ValueLeft(tc.input), tol)
+			assert.InDelta(t, tc.gradient, interpolator.Gradient(tc.input), tol)
+		})
+	}
+
+	xs := []float64{0.5, 1.0, 1.5}
+	values := make([]float64, len(xs))
+	interpolator.ValuesInto(values, xs)
+	assert.InDeltaSlice(t, []float64{0.5, 3.0, 3.5}, values, tol)
+
+	assert.InDelta(t, 4.0, interpolator.Integral(0.0, 2.0), tol)
+	assert.InDelta(t, 4.0, interpolator.Antiderivative().Value(2.0), tol)
+
+	assert.Equal(t, []Crossing{{From: 1.0, To: 1.0}}, interpolator.Crossings(2.0, 0.0, 2.0))
+
+	lo, hi := interpolator.Range(0.0, 1.0)
+	assert.Equal(t, XY{X: 0.0, Y: 0.0}, lo)
+	assert.Equal(t, XY{X: 1.0, Y: 3.0}, hi)
+
+	x, err := interpolator.Inverse(2.0)
+	require.NoError(t, err)
+	assert.InDelta(t, 1.0, x, tol)
+	x, err = interpolator.Inverse(3.5)
+	require.NoError(t, err)
+	assert.InDelta(t, 1.5, x, tol)
+}
+
+func TestPiecewiseLinearJumpsSearch(t *testing.T) {
+	xys := make(XYs, 0, 40)
+	for i := 0; i < 20; i++ {
+		x := float64(i)
+		xys = append(xys, XY{X: x, Y: x})
+		if i > 0 && i < 19 {
+			xys = append(xys, XY{X: x, Y: x + 0.5})
+		}
+	}
+
+	for _, strategy := range []SearchStrategy{SearchAuto, SearchBinary, SearchInterpolation, SearchEytzinger} {
+		interpolator, err := NewPiecewiseLinear(xys, WithJumps(), WithSearch(strategy))
+		require.NoError(t, err)
+		for i := 1; i < 19; i++ {
+			x := float64(i)
+			assert.InDelta(t, x+0.5, interpolator.Value(x), 1.0e-12, "strategy=%v x=%v", strategy, x)
+			assert.InDelta(t, x, interpolator.ValueLeft(x), 1.0e-12, "strategy=%v x=%v", strategy, x)
+			assert.InDelta(t, x+0.75, interpolator.Value(x+0.5), 1.0e-12, "strategy=%v x=%v", strategy, x)
+		}
+	}
+}
+
+func TestPiecewiseLinearJumpsPrepare(t *testing.T) {
+	interpolator, err := NewPiecewiseLinear(XYs{testJumpXYs[3], testJumpXYs[1], testJumpXYs[0], testJumpXYs[2]},
+		WithJumps(), WithPrepare(KeepLast))
+	require.NoError(t, err)
+	assert.Equal(t, testJumpXYs, interpolator.Points())
+}
+
 func ExamplePiecewiseLinear_Value() {
 	xys := XYs{
 		{
@@ -200,3 +394,44 @@ func ExamplePiecewiseLinear_Gradient() {
 	fmt.Printf("%0.1f\n", interp.Gradient(0.75))
 	// Output: 0.8
 }
+
+func TestNewPiecewiseLinearCopiesXYs(t *testing.T) {
+	xys := testLinearXYs.Copy()
+	interpolator, err := NewPiecewiseLinear(xys)
+	require.NoError(t, err)
+
+	xys[1].Y = 100.0
+	assert.InEpsilon(t, testLinearFunc(0.5), interpolator.Value(0.5), 1.0e-12)
+}
+
+func BenchmarkPiecewiseLinearValue(b *testing.B) {
+	interpolator, err := NewPiecewiseLinear(testLinearXYs)
+	require.NoError(b, err)
+	var (
+		x = 0.7
+		y = testLinearFunc(x)
+		v float64
+	)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		v = interpolator.Value(x)
+	}
+	b.StopTimer()
+	assert.InDelta(b, y, v, 1.0e-8)
+}
+
+func BenchmarkPiecewiseLinearDerivative(b *testing.B) {
+	interpolator, err := NewPiecewiseLinear(testLinearXYs)
+	require.NoError(b, err)
+	var (
+		x = 0.7
+		y = 1.2
+		v float64
+	)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		v = interpolator.Gradient(x)
+	}
+	b.StopTimer()
+	assert.InDelta(b, y, v, 1.0e-8)
+}
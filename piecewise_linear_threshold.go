@@ -1,55 +1,50 @@
 package interpolator
 
-import "fmt"
-
 // PiecewiseLinearThreshold is a piecewise linear interpolator that extrapolates a threshold value.
+// It is equivalent to a PiecewiseLinear with flat extrapolation on both sides.
 type PiecewiseLinearThreshold struct {
-	xys XYs
+	*curve
 }
 
 // NewPiecewiseLinearThreshold builds a piecewise linear interpolator with flat extrapolation.
-// The input `xys` must be ordered and have unique abscissas.
-func NewPiecewiseLinearThreshold(xys XYs) (*PiecewiseLinearThreshold, error) {
-	if l := len(xys); l < 1 {
-		return nil, fmt.Errorf("at least 1 points is required to build a piecewise linear threshold interpolator, but got %d", l)
+// The input `xys` must be ordered and have unique abscissas,
+// otherwise a *PointError is returned.
+func NewPiecewiseLinearThreshold(xys XYs, opts ...Option) (*PiecewiseLinearThreshold, error) {
+	cfg := newConfig(ExtrapolateFlat(), opts)
+
+	xys, err := cfg.prepare("piecewise linear threshold", xys)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := newCurve(xys, cfg, newLinearPiece)
+	if err != nil {
+		return nil, err
 	}
 
 	return &PiecewiseLinearThreshold{
-		xys: xys,
+		curve: c,
 	}, nil
 }
 
 // Value compute the value of f(x) based on piecewise linear interpolation with flat extrapolation.
 func (interp PiecewiseLinearThreshold) Value(x float64) float64 {
-	if n := len(interp.xys); n == 1 {
-		// In case a single data point is provided, assume a constant curve
-		return interp.xys[0].Y
-	}
-
-	p1, p2 := interp.xys.Interval(x)
-	if x <= p1.X {
-		return p1.Y
-	}
-	if x >= p2.X {
-		return p2.Y
-	}
-
-	lambda := (x - p1.X) / (p2.X - p1.X)
-
-	return p1.Y*(1.0-lambda) + p2.Y*lambda
+	return interp.value(x)
 }
 
 // Gradient computes the gradient of f(x) based on piecewise linear interpolation with flat extrapolation.
 func (interp PiecewiseLinearThreshold) Gradient(x float64) float64 {
-	if n := len(interp.xys); n == 1 {
-		// In case a single data point is provided, assume a constant curve
-		return 0.0
-	}
+	return interp.gradient(x)
+}
 
-	p1, p2 := interp.xys.Interval(x)
-	if x <= p1.X || x >= p2.X {
-		return 0.0
-	}
+// SecondDerivative computes the second derivative of f(x) based on piecewise linear interpolation, which is zero.
+func (interp PiecewiseLinearThreshold) SecondDerivative(x float64) float64 {
+	return interp.secondDerivative(x)
+}
 
-	return (p2.Y - p1.Y) / (p2.X - p1.X)
+// Inverse returns the abscissa x such that f(x) = y based on piecewise linear interpolation.
+// The input data must have strictly monotone ordinates, otherwise an error wrapping ErrNotMonotone
+// is returned, and y must lie between the first and last ordinates, otherwise ErrOutOfRange is wrapped.
+func (interp PiecewiseLinearThreshold) Inverse(y float64) (float64, error) {
+	return interp.inverse(y)
 }
@@ -15,7 +15,21 @@ func TestNewPiecewiseLinearThreshold(t *testing.T) {
 
 func TestNewPiecewiseLinearThresholdEmptyXYs(t *testing.T) {
 	_, err := NewPiecewiseLinearThreshold(XYs{})
-	require.Error(t, err)
+	require.ErrorIs(t, err, ErrNotEnoughPoints)
+}
+
+func TestNewPiecewiseLinearThresholdUnsortedXYs(t *testing.T) {
+	_, err := NewPiecewiseLinearThreshold(XYs{
+		{
+			X: 1.0,
+			Y: 1.0,
+		},
+		{
+			X: 0.0,
+			Y: 1.0,
+		},
+	})
+	require.ErrorIs(t, err, ErrUnsorted)
 }
 
 func TestNewPiecewiseLinearThresholdSinglePoint(t *testing.T) {
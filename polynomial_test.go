@@ -0,0 +1,177 @@
+package interpolator
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testChebyshevXYs samples f at the n Chebyshev points of the second kind on [-1, 1].
+func testChebyshevXYs(n int, f func(float64) float64) XYs {
+	xys := make(XYs, n)
+	for i := range xys {
+		x := -math.Cos(math.Pi * float64(i) / float64(n-1))
+		xys[i] = XY{X: x, Y: f(x)}
+	}
+
+	return xys
+}
+
+// testRunge is the function of Runge's phenomenon.
+func testRunge(x float64) float64 {
+	return 1.0 / (1.0 + 25.0*x*x)
+}
+
+func TestNewPolynomialEmptyXYs(t *testing.T) {
+	_, err := NewPolynomial(XYs{})
+	require.ErrorIs(t, err, ErrNotEnoughPoints)
+}
+
+func TestNewPolynomialUnsortedXYs(t *testing.T) {
+	_, err := NewPolynomial(XYs{
+		{
+			X: 1.0,
+			Y: 1.0,
+		},
+		{
+			X: 0.0,
+			Y: 1.0,
+		},
+	})
+	require.ErrorIs(t, err, ErrUnsorted)
+}
+
+func TestNewPolynomialSinglePoint(t *testing.T) {
+	const tol = 1e-15
+
+	interpolator, err := NewPolynomial(XYs{
+		{
+			X: 0.0,
+			Y: 1.0,
+		},
+	})
+	require.NoError(t, err)
+
+	for _, x := range []float64{-1.0, 0.0, 1.0} {
+		assert.InDelta(t, 1.0, interpolator.Value(x), tol)
+		assert.InDelta(t, 0.0, interpolator.Gradient(x), tol)
+		assert.InDelta(t, 0.0, interpolator.SecondDerivative(x), tol)
+	}
+}
+
+func TestPolynomialCubic(t *testing.T) {
+	const tol = 1.0e-11
+
+	interpolator, err := NewPolynomial(testCubicXYs)
+	require.NoError(t, err)
+
+	// The knots are included, as well as points outside of the domain.
+	for _, x := range []float64{-1.0, 0.0, 0.2, 0.4, 1.3, 1.7, 2.9, 3.0, 4.0} {
+		y, d1, d2 := testCubicFunc(x)
+		assert.InDelta(t, y, interpolator.Value(x), tol, "x=%v", x)
+		assert.InDelta(t, d1, interpolator.Gradient(x), tol, "x=%v", x)
+		assert.InDelta(t, d2, interpolator.SecondDerivative(x), tol, "x=%v", x)
+	}
+
+	xMin, xMax := interpolator.Domain()
+	assert.Equal(t, 0.0, xMin)
+	assert.Equal(t, 3.0, xMax)
+	assert.Equal(t, testCubicXYs, interpolator.Points())
+}
+
+func TestPolynomialChebyshevConvergence(t *testing.T) {
+	testCases := []struct {
+		name      string
+		n         int
+		f         func(float64) float64
+		tolerance float64
+	}{
+		{
+			"Exp",
+			20,
+			math.Exp,
+			1.0e-14,
+		},
+		{
+			"Runge",
+			101,
+			testRunge,
+			1.0e-7,
+		},
+		{
+			// The weights would overflow without rescaling.
+			"ManyPoints",
+			1001,
+			math.Cos,
+			1.0e-14,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			interpolator, err := NewPolynomial(testChebyshevXYs(tc.n, tc.f))
+			require.NoError(t, err)
+
+			for _, x := range testGrid(-1.0, 1.0, 201) {
+				assert.InDelta(t, tc.f(x), interpolator.Value(x), tc.tolerance, "x=%v", x)
+			}
+		})
+	}
+}
+
+func TestPolynomialDerivatives(t *testing.T) {
+	const h = 1.0e-5
+
+	interpolator, err := NewPolynomial(testChebyshevXYs(9, math.Sin))
+	require.NoError(t, err)
+
+	for _, x := range append(testGrid(-0.95, 0.95, 11), interpolator.Points()[3].X) {
+		gradient := (interpolator.Value(x+h) - interpolator.Value(x-h)) / (2.0 * h)
+		second := (interpolator.Gradient(x+h) - interpolator.Gradient(x-h)) / (2.0 * h)
+		assert.InDelta(t, gradient, interpolator.Gradient(x), 1.0e-8, "x=%v", x)
+		assert.InDelta(t, second, interpolator.SecondDerivative(x), 1.0e-7, "x=%v", x)
+		assert.InDelta(t, math.Cos(x), interpolator.Gradient(x), 1.0e-6, "x=%v", x)
+	}
+}
+
+func ExamplePolynomial_Value() {
+	xys := XYs{
+		{
+			X: 0.0,
+			Y: 0.0,
+		},
+		{
+			X: 1.0,
+			Y: 1.0,
+		},
+		{
+			X: 2.0,
+			Y: 4.0,
+		},
+	}
+	interp, err := NewPolynomial(xys)
+	if err != nil {
+		return
+	}
+	fmt.Printf("%.4f\n", interp.Value(1.5))
+	// Output: 2.2500
+}
+
+func BenchmarkPolynomialValue(b *testing.B) {
+	interpolator, err := NewPolynomial(testCubicXYs)
+	require.NoError(b, err)
+	var (
+		x       = 1.3
+		y, _, _ = testCubicFunc(x)
+		v       float64
+	)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		v = interpolator.Value(x)
+	}
+	b.StopTimer()
+	assert.InDelta(b, y, v, 1.0e-12)
+}
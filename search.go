@@ -0,0 +1,263 @@
+package interpolator
+
+import (
+	"errors"
+	"math"
+	"math/bits"
+)
+
+// ErrIrregularGrid is returned when requesting the direct search on abscissas
+// which are neither evenly nor geometrically spaced.
+var ErrIrregularGrid = errors.New("abscissas are not evenly or geometrically spaced")
+
+// SearchStrategy defines how the segment containing an abscissa is found.
+type SearchStrategy int
+
+const (
+	// SearchAuto selects the direct search for evenly or geometrically spaced abscissas,
+	// the Eytzinger search for large tables, and the binary search otherwise.
+	SearchAuto SearchStrategy = iota
+	// SearchBinary performs a binary search on the abscissas.
+	SearchBinary
+	// SearchDirect computes the segment index in constant time, for evenly or geometrically spaced abscissas.
+	SearchDirect
+	// SearchInterpolation guesses the segment index by linear interpolation of the abscissas,
+	// which takes O(log log n) steps for roughly evenly spaced abscissas.
+	SearchInterpolation
+	// SearchEytzinger performs a binary search on a copy of the abscissas laid out in breadth-first order,
+	// which is more cache friendly for very large tables.
+	SearchEytzinger
+)
+
+const (
+	// eytzingerThreshold is the number of points from which SearchAuto selects the Eytzinger search.
+	eytzingerThreshold = 1 << 14
+	// gridTolerance is the relative tolerance on the spacing of the abscissas of a regular grid.
+	gridTolerance = 1.0e-9
+)
+
+// searcher finds the index of the segment containing an abscissa inside the domain.
+// A data point belongs to the segment on its right,
+// except for the last data point which belongs to the last segment.
+type searcher interface {
+	segment(x float64) int
+}
+
+// newSearcher returns the searcher implementing the strategy on the abscissas `xs`.
+func newSearcher(strategy SearchStrategy, xs []float64) (searcher, error) {
+	if len(xs) < 3 {
+		return binarySearch(xs), nil
+	}
+
+	switch strategy {
+	case SearchBinary:
+		return binarySearch(xs), nil
+	case SearchInterpolation:
+		return interpolationSearch(xs), nil
+	case SearchEytzinger:
+		return newEytzingerSearch(xs), nil
+	case SearchDirect:
+		if s, ok := newDirectSearch(xs); ok {
+			return s, nil
+		}
+
+		return nil, ErrIrregularGrid
+	default:
+		if s, ok := newDirectSearch(xs); ok {
+			return s, nil
+		}
+		if len(xs) >= eytzingerThreshold {
+			return newEytzingerSearch(xs), nil
+		}
+
+		return binarySearch(xs), nil
+	}
+}
+
+// clamp returns the segment index i, bounded by the index of the last segment of `xs`.
+func clamp(i int, xs []float64) int {
+	if last := len(xs) - 2; i > last {
+		if last < 0 {
+			return 0
+		}
+
+		return last
+	}
+
+	return i
+}
+
+type binarySearch []float64
+
+func (xs binarySearch) segment(x float64) int {
+	// Find the first abscissa larger than x.
+	lo, hi := 0, len(xs)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if xs[mid] <= x {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+
+	return clamp(lo-1, xs)
+}
+
+type interpolationSearch []float64
+
+func (xs interpolationSearch) segment(x float64) int {
+	lo, hi := 0, len(xs)-1
+	if x >= xs[hi] {
+		return clamp(hi, xs)
+	}
+
+	// Keep xs[lo] <= x < xs[hi], alternating with bisection steps
+	// whenever an interpolation step does not halve the bracket.
+	for hi-lo > 1 {
+		width := hi - lo
+		mid := lo + int(float64(width)*(x-xs[lo])/(xs[hi]-xs[lo]))
+		if mid <= lo {
+			mid = lo + 1
+		} else if mid >= hi {
+			mid = hi - 1
+		}
+		if xs[mid] <= x {
+			lo = mid
+		} else {
+			hi = mid
+		}
+
+		if 2*(hi-lo) > width && hi-lo > 1 {
+			mid = lo + (hi-lo)/2
+			if xs[mid] <= x {
+				lo = mid
+			} else {
+				hi = mid
+			}
+		}
+	}
+
+	return lo
+}
+
+// directSearch computes the segment index of evenly spaced abscissas,
+// or of the logarithm of geometrically spaced abscissas.
+type directSearch struct {
+	xs       []float64
+	origin   float64
+	invWidth float64
+	log      bool
+}
+
+func newDirectSearch(xs []float64) (directSearch, bool) {
+	n := len(xs)
+	if isRegular(xs, func(x float64) float64 { return x }) {
+		return directSearch{
+			xs:       xs,
+			origin:   xs[0],
+			invWidth: float64(n-1) / (xs[n-1] - xs[0]),
+		}, true
+	}
+
+	if xs[0] > 0.0 && isRegular(xs, math.Log) {
+		origin := math.Log(xs[0])
+
+		return directSearch{
+			xs:       xs,
+			origin:   origin,
+			invWidth: float64(n-1) / (math.Log(xs[n-1]) - origin),
+			log:      true,
+		}, true
+	}
+
+	return directSearch{}, false
+}
+
+// isRegular reports whether the transformed abscissas are evenly spaced.
+func isRegular(xs []float64, transform func(float64) float64) bool {
+	n := len(xs)
+	first, last := transform(xs[0]), transform(xs[n-1])
+	width := (last - first) / float64(n-1)
+	for i, x := range xs {
+		if math.Abs(transform(x)-(first+float64(i)*width)) > gridTolerance*width {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s directSearch) segment(x float64) int {
+	t := x
+	if s.log {
+		t = math.Log(x)
+	}
+
+	i := int((t - s.origin) * s.invWidth)
+	last := len(s.xs) - 2
+	if i < 0 {
+		i = 0
+	} else if i > last {
+		i = last
+	}
+
+	// Correct rounding errors on the abscissas close to the data points.
+	for i > 0 && s.xs[i] > x {
+		i--
+	}
+	for i < last && s.xs[i+1] <= x {
+		i++
+	}
+
+	return i
+}
+
+// eytzingerSearch stores the abscissas in the breadth-first order of a complete binary search tree,
+// starting at index 1, along with their original indices.
+type eytzingerSearch struct {
+	tree    []float64
+	indices []int
+}
+
+func newEytzingerSearch(xs []float64) eytzingerSearch {
+	s := eytzingerSearch{
+		tree:    make([]float64, len(xs)+1),
+		indices: make([]int, len(xs)+1),
+	}
+	s.fill(xs, 0, 1)
+
+	return s
+}
+
+// fill lays out the `xs` starting from the position `i`, into the subtree rooted at `k`,
+// and returns the next position to lay out.
+func (s eytzingerSearch) fill(xs []float64, i, k int) int {
+	if k < len(s.tree) {
+		i = s.fill(xs, i, 2*k)
+		s.tree[k] = xs[i]
+		s.indices[k] = i
+		i++
+		i = s.fill(xs, i, 2*k+1)
+	}
+
+	return i
+}
+
+func (s eytzingerSearch) segment(x float64) int {
+	k := 1
+	for k < len(s.tree) {
+		k = 2 * k
+		if s.tree[k/2] <= x {
+			k++
+		}
+	}
+	// Go back up to the first ancestor reached from its left child,
+	// which holds the first abscissa larger than x.
+	k >>= uint(bits.TrailingZeros(^uint(k)) + 1)
+	if k == 0 {
+		return len(s.tree) - 3
+	}
+
+	return clamp(s.indices[k]-1, s.tree[1:])
+}
@@ -0,0 +1,129 @@
+package interpolator
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testSearchGrids() map[string][]float64 {
+	rnd := rand.New(rand.NewSource(1))
+
+	irregular := make([]float64, 1000)
+	for i := 1; i < len(irregular); i++ {
+		irregular[i] = irregular[i-1] + rnd.ExpFloat64()
+	}
+
+	geometric := make([]float64, 200)
+	for i := range geometric {
+		geometric[i] = 0.01 * math.Pow(1.1, float64(i))
+	}
+
+	return map[string][]float64{
+		"Two":       {0.0, 1.0},
+		"Uniform":   testGrid(-1.0, 3.0, 101),
+		"Geometric": geometric,
+		"Irregular": irregular,
+		"Large":     testGrid(0.0, 1.0, eytzingerThreshold+7),
+	}
+}
+
+func TestSearch(t *testing.T) {
+	rnd := rand.New(rand.NewSource(2))
+
+	for name, xs := range testSearchGrids() {
+		xs := xs
+		expected := binarySearch(xs)
+		queries := append([]float64(nil), xs...)
+		for i := 0; i < 1000; i++ {
+			queries = append(queries, xs[0]+rnd.Float64()*(xs[len(xs)-1]-xs[0]))
+		}
+
+		for _, strategy := range []SearchStrategy{SearchAuto, SearchBinary, SearchDirect, SearchInterpolation, SearchEytzinger} {
+			s, err := newSearcher(strategy, xs)
+			if strategy == SearchDirect && name == "Irregular" {
+				require.ErrorIs(t, err, ErrIrregularGrid)
+
+				continue
+			}
+			require.NoError(t, err, name)
+
+			for _, x := range queries {
+				assert.Equal(t, expected.segment(x), s.segment(x), "%s %d at %v", name, strategy, x)
+			}
+		}
+	}
+}
+
+func TestSearchAuto(t *testing.T) {
+	grids := testSearchGrids()
+
+	s, err := newSearcher(SearchAuto, grids["Uniform"])
+	require.NoError(t, err)
+	assert.IsType(t, directSearch{}, s)
+	assert.False(t, s.(directSearch).log)
+
+	s, err = newSearcher(SearchAuto, grids["Geometric"])
+	require.NoError(t, err)
+	assert.IsType(t, directSearch{}, s)
+	assert.True(t, s.(directSearch).log)
+
+	s, err = newSearcher(SearchAuto, grids["Irregular"])
+	require.NoError(t, err)
+	assert.IsType(t, binarySearch{}, s)
+
+	xs := append([]float64(nil), grids["Large"]...)
+	xs[1] = 0.5 * xs[1]
+	s, err = newSearcher(SearchAuto, xs)
+	require.NoError(t, err)
+	assert.IsType(t, eytzingerSearch{}, s)
+}
+
+func TestWithSearch(t *testing.T) {
+	_, err := NewPiecewiseLinear(XYs{{X: 0.0, Y: 1.0}, {X: 1.0, Y: 2.0}, {X: 3.0, Y: 3.0}}, WithSearch(SearchDirect))
+	require.ErrorIs(t, err, ErrIrregularGrid)
+
+	interpolator, err := NewPiecewiseLinear(testLinearXYs, WithSearch(SearchDirect))
+	require.NoError(t, err)
+	assert.InDelta(t, testLinearFunc(1.3), interpolator.Value(1.3), 1.0e-12)
+}
+
+func benchmarkSearch(b *testing.B, strategy SearchStrategy, xs []float64) {
+	b.Helper()
+
+	s, err := newSearcher(strategy, xs)
+	require.NoError(b, err)
+
+	rnd := rand.New(rand.NewSource(1))
+	queries := make([]float64, 1024)
+	for i := range queries {
+		queries[i] = xs[0] + rnd.Float64()*(xs[len(xs)-1]-xs[0])
+	}
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		s.segment(queries[n%len(queries)])
+	}
+}
+
+func BenchmarkSearchBinaryUniform(b *testing.B) {
+	benchmarkSearch(b, SearchBinary, testGrid(0.0, 1.0, 1000))
+}
+
+func BenchmarkSearchDirectUniform(b *testing.B) {
+	benchmarkSearch(b, SearchDirect, testGrid(0.0, 1.0, 1000))
+}
+
+func BenchmarkSearchInterpolationUniform(b *testing.B) {
+	benchmarkSearch(b, SearchInterpolation, testGrid(0.0, 1.0, 1000))
+}
+
+func BenchmarkSearchBinaryLarge(b *testing.B) {
+	benchmarkSearch(b, SearchBinary, testGrid(0.0, 1.0, 1<<22))
+}
+
+func BenchmarkSearchEytzingerLarge(b *testing.B) {
+	benchmarkSearch(b, SearchEytzinger, testGrid(0.0, 1.0, 1<<22))
+}
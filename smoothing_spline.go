@@ -0,0 +1,393 @@
+package interpolator
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+type smoothingKind int
+
+const (
+	gcvSmoothing smoothingKind = iota
+	parameterSmoothing
+	residualSmoothing
+)
+
+// Smoothing defines how the smoothing parameter of a smoothing spline is chosen.
+// The zero value selects it by generalized cross-validation.
+type Smoothing struct {
+	kind  smoothingKind
+	param float64
+}
+
+// SmoothingParameter sets the weight of the roughness penalty, which must be non-negative:
+// 0 yields the natural cubic spline interpolating the data,
+// and the fit tends to the weighted least squares line as the weight grows.
+func SmoothingParameter(lambda float64) Smoothing {
+	return Smoothing{kind: parameterSmoothing, param: lambda}
+}
+
+// SmoothingResidual chooses the smoothest spline whose weighted residual sum of squares
+// does not exceed the target, following Reinsch (1967).
+func SmoothingResidual(target float64) Smoothing {
+	return Smoothing{kind: residualSmoothing, param: target}
+}
+
+// SmoothingGCV chooses the smoothing parameter minimizing the generalized cross-validation score,
+// following Craven and Wahba (1979).
+func SmoothingGCV() Smoothing {
+	return Smoothing{kind: gcvSmoothing}
+}
+
+const (
+	// smoothingRange is the range of decimal exponents, around a scale derived from the data,
+	// in which the smoothing parameter is searched.
+	smoothingRange = 12.0
+	// smoothingGridStep is the step of the decimal exponents of the grid on which
+	// the generalized cross-validation score is first minimized.
+	smoothingGridStep = 0.5
+	// smoothingTolerance is the tolerance on the decimal exponent of the selected smoothing parameter.
+	smoothingTolerance = 1.0e-6
+)
+
+// SmoothingSpline is a cubic smoothing spline, which fits noisy data by minimizing
+// the weighted residual sum of squares plus a penalty on the integral of the squared second derivative.
+// It is a natural cubic spline going through fitted values at the abscissas of the data points.
+type SmoothingSpline struct {
+	*curve
+	points XYs
+	lambda float64
+}
+
+// NewSmoothingSpline builds a smoothing spline.
+// The input `xys` must be ordered and have unique abscissas,
+// otherwise a *PointError is returned.
+// The data points can be weighted, see WithWeights, and by default the smoothing parameter
+// is chosen by generalized cross-validation, see WithSmoothing.
+// By default, the edge segments are extended for extrapolation.
+func NewSmoothingSpline(xys XYs, opts ...Option) (*SmoothingSpline, error) {
+	cfg := newConfig(ExtrapolateEdge(), opts)
+	if cfg.weights != nil && cfg.prepareInput {
+		return nil, errors.New("weights cannot be combined with the preparation of the input data")
+	}
+
+	xys, err := cfg.prepare("smoothing spline", xys)
+	if err != nil {
+		return nil, err
+	}
+
+	weights, err := smoothingWeights(cfg.weights, len(xys))
+	if err != nil {
+		return nil, err
+	}
+
+	fit := newSmoothingFit(xys, weights)
+	lambda, err := fit.choose(cfg.smoothing)
+	if err != nil {
+		return nil, err
+	}
+
+	fitted, slopes, err := fit.spline(lambda)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := newHermiteCurve(fitted, slopes, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SmoothingSpline{
+		curve:  c,
+		points: xys,
+		lambda: lambda,
+	}, nil
+}
+
+// Value computes the value of f(x) based on the smoothing spline.
+func (interp SmoothingSpline) Value(x float64) float64 {
+	return interp.value(x)
+}
+
+// Gradient computes the gradient of f(x) based on the smoothing spline.
+func (interp SmoothingSpline) Gradient(x float64) float64 {
+	return interp.gradient(x)
+}
+
+// SecondDerivative computes the second derivative of f(x) based on the smoothing spline,
+// which is continuous.
+func (interp SmoothingSpline) SecondDerivative(x float64) float64 {
+	return interp.secondDerivative(x)
+}
+
+// Points returns a copy of the input data, see Fitted for the points the spline goes through.
+func (interp SmoothingSpline) Points() XYs {
+	return interp.points.Copy()
+}
+
+// Fitted returns a copy of the fitted points, at the abscissas of the input data,
+// which the spline goes through.
+func (interp SmoothingSpline) Fitted() XYs {
+	return interp.xys.Copy()
+}
+
+// Smoothing returns the weight of the roughness penalty, as set or selected at construction.
+func (interp SmoothingSpline) Smoothing() float64 {
+	return interp.lambda
+}
+
+// smoothingWeights returns the weights of the n data points, which default to 1.
+func smoothingWeights(weights []float64, n int) ([]float64, error) {
+	if weights == nil {
+		res := make([]float64, n)
+		for i := range res {
+			res[i] = 1.0
+		}
+
+		return res, nil
+	}
+
+	if len(weights) != n {
+		return nil, fmt.Errorf("%w: got %d weights for %d points", ErrLengthMismatch, len(weights), n)
+	}
+	for i, w := range weights {
+		if math.IsNaN(w) || math.IsInf(w, 0) {
+			return nil, fmt.Errorf("%w: weight %d is %v", ErrNotFinite, i, w)
+		}
+		if w <= 0.0 {
+			return nil, fmt.Errorf("%w: weight %d is %v", ErrNonPositive, i, w)
+		}
+	}
+
+	return append([]float64(nil), weights...), nil
+}
+
+// smoothingFit holds the matrices of the Reinsch algorithm, following Green and Silverman (1994):
+// with Q the second divided differences and R the integrals of the products of the hat functions,
+// the second derivatives gamma at the inner data points solve (R + lambda*Q^T*W^-1*Q)*gamma = Q^T*y,
+// and the fitted values are y - lambda*W^-1*Q*gamma.
+type smoothingFit struct {
+	xys     XYs
+	weights []float64
+	h       []float64
+	// qty is Q^T*y.
+	qty []float64
+	// r0, r1 are the diagonal and off-diagonal of R.
+	r0, r1 []float64
+	// p0, p1, p2 are the diagonal and off-diagonals of Q^T*W^-1*Q.
+	p0, p1, p2 []float64
+	// scale is the order of magnitude of the smoothing parameter, where both terms of the matrix balance.
+	scale float64
+}
+
+func newSmoothingFit(xys XYs, weights []float64) *smoothingFit {
+	n := len(xys)
+	f := &smoothingFit{
+		xys:     xys,
+		weights: weights,
+		scale:   1.0,
+	}
+	if n < 3 {
+		return f
+	}
+
+	f.h = make([]float64, n-1)
+	for i := range f.h {
+		f.h[i] = xys[i+1].X - xys[i].X
+	}
+
+	m := n - 2
+	f.qty = make([]float64, m)
+	f.r0, f.r1 = make([]float64, m), make([]float64, m)
+	f.p0, f.p1, f.p2 = make([]float64, m), make([]float64, m), make([]float64, m)
+	traceR, traceP := 0.0, 0.0
+	for j := 0; j < m; j++ {
+		// Q has the non-zero elements u, v and t on rows j, j+1 and j+2 of its column j.
+		u, v, t := f.column(j)
+		f.qty[j] = u*xys[j].Y + v*xys[j+1].Y + t*xys[j+2].Y
+		f.r0[j] = (f.h[j] + f.h[j+1]) / 3.0
+		f.p0[j] = u*u/weights[j] + v*v/weights[j+1] + t*t/weights[j+2]
+		if j+1 < m {
+			u1, v1, _ := f.column(j + 1)
+			f.r1[j] = f.h[j+1] / 6.0
+			f.p1[j] = v*u1/weights[j+1] + t*v1/weights[j+2]
+		}
+		if j+2 < m {
+			u2, _, _ := f.column(j + 2)
+			f.p2[j] = t * u2 / weights[j+2]
+		}
+		traceR += f.r0[j]
+		traceP += f.p0[j]
+	}
+	f.scale = traceR / traceP
+
+	return f
+}
+
+// column returns the non-zero elements of the column j of Q.
+func (f *smoothingFit) column(j int) (float64, float64, float64) {
+	return 1.0 / f.h[j], -1.0/f.h[j] - 1.0/f.h[j+1], 1.0 / f.h[j+1]
+}
+
+// solve returns the second derivatives at the inner data points and the fitted values,
+// along with the decomposition of the matrix of the system.
+func (f *smoothingFit) solve(lambda float64) ([]float64, []float64, *pentadiagonalLDL) {
+	n := len(f.xys)
+	fitted := make([]float64, n)
+	for i, xy := range f.xys {
+		fitted[i] = xy.Y
+	}
+	if n < 3 {
+		return nil, fitted, nil
+	}
+
+	m := n - 2
+	e0, e1, e2 := make([]float64, m), make([]float64, m), make([]float64, m)
+	for j := 0; j < m; j++ {
+		e0[j] = f.r0[j] + lambda*f.p0[j]
+		e1[j] = f.r1[j] + lambda*f.p1[j]
+		e2[j] = lambda * f.p2[j]
+	}
+	ldl := newPentadiagonalLDL(e0, e1, e2)
+
+	gamma := append([]float64(nil), f.qty...)
+	ldl.solve(gamma)
+
+	for j, g := range gamma {
+		u, v, t := f.column(j)
+		fitted[j] -= lambda * u * g / f.weights[j]
+		fitted[j+1] -= lambda * v * g / f.weights[j+1]
+		fitted[j+2] -= lambda * t * g / f.weights[j+2]
+	}
+
+	return gamma, fitted, ldl
+}
+
+// residual returns the weighted residual sum of squares of the fitted values.
+func (f *smoothingFit) residual(fitted []float64) float64 {
+	res := 0.0
+	for i, xy := range f.xys {
+		d := xy.Y - fitted[i]
+		res += f.weights[i] * d * d
+	}
+
+	return res
+}
+
+// gcv returns the generalized cross-validation score n*RSS/(n - tr(A))^2, where A is the influence matrix.
+// As I - A = lambda*W^-1*Q*M^-1*Q^T, its trace only requires the band of the inverse of M.
+func (f *smoothingFit) gcv(lambda float64) float64 {
+	_, fitted, ldl := f.solve(lambda)
+	s0, s1, s2 := ldl.inverseBand()
+
+	trace := 0.0
+	for j := range s0 {
+		trace += s0[j]*f.p0[j] + 2.0*(s1[j]*f.p1[j]+s2[j]*f.p2[j])
+	}
+	n := float64(len(f.xys))
+	dof := lambda * trace
+
+	return n * f.residual(fitted) / (dof * dof)
+}
+
+// lambda returns the smoothing parameter of decimal exponent p relative to the scale of the data.
+func (f *smoothingFit) lambda(p float64) float64 {
+	return f.scale * math.Pow(10.0, p)
+}
+
+// choose returns the smoothing parameter chosen according to the given method.
+func (f *smoothingFit) choose(smoothing Smoothing) (float64, error) {
+	switch smoothing.kind {
+	case parameterSmoothing:
+		if !(smoothing.param >= 0.0) || math.IsInf(smoothing.param, 1) {
+			return 0.0, fmt.Errorf("invalid smoothing parameter %v", smoothing.param)
+		}
+
+		return smoothing.param, nil
+	case residualSmoothing:
+		if math.IsNaN(smoothing.param) {
+			return 0.0, fmt.Errorf("invalid target residual %v", smoothing.param)
+		}
+		if len(f.xys) < 3 || smoothing.param <= 0.0 {
+			return 0.0, nil
+		}
+
+		// The residual increases with the smoothing parameter.
+		lo, hi := -smoothingRange, smoothingRange
+		for hi-lo > smoothingTolerance {
+			mid := 0.5 * (lo + hi)
+			if _, fitted, _ := f.solve(f.lambda(mid)); f.residual(fitted) > smoothing.param {
+				hi = mid
+			} else {
+				lo = mid
+			}
+		}
+
+		return f.lambda(lo), nil
+	case gcvSmoothing:
+		if len(f.xys) < 3 {
+			return 0.0, nil
+		}
+
+		// Bracket the global minimum on a grid, then refine it by golden section search.
+		best, bestScore := -smoothingRange, math.Inf(1)
+		for k := 0; k <= int(2.0*smoothingRange/smoothingGridStep); k++ {
+			p := -smoothingRange + float64(k)*smoothingGridStep
+			if score := f.gcv(f.lambda(p)); score < bestScore {
+				best, bestScore = p, score
+			}
+		}
+
+		ratio := 0.5 * (math.Sqrt(5.0) - 1.0)
+		lo, hi := best-smoothingGridStep, best+smoothingGridStep
+		p1, p2 := hi-ratio*(hi-lo), lo+ratio*(hi-lo)
+		s1, s2 := f.gcv(f.lambda(p1)), f.gcv(f.lambda(p2))
+		for hi-lo > smoothingTolerance {
+			if s1 < s2 {
+				hi, p2, s2 = p2, p1, s1
+				p1 = hi - ratio*(hi-lo)
+				s1 = f.gcv(f.lambda(p1))
+			} else {
+				lo, p1, s1 = p1, p2, s2
+				p2 = lo + ratio*(hi-lo)
+				s2 = f.gcv(f.lambda(p2))
+			}
+		}
+
+		return f.lambda(0.5 * (lo + hi)), nil
+	default:
+		return 0.0, fmt.Errorf("unknown smoothing method %d", smoothing.kind)
+	}
+}
+
+// spline returns the fitted points and the slopes at the data points of the smoothing spline
+// with the given smoothing parameter.
+func (f *smoothingFit) spline(lambda float64) (XYs, []float64, error) {
+	gamma, values, _ := f.solve(lambda)
+
+	n := len(f.xys)
+	fitted := make(XYs, n)
+	for i, xy := range f.xys {
+		fitted[i] = XY{X: xy.X, Y: values[i]}
+	}
+	if n < 3 {
+		slopes, err := splineSlopes(fitted, BoundaryNatural(), BoundaryNatural())
+
+		return fitted, slopes, err
+	}
+
+	// The second derivatives vanish at both ends of a natural spline.
+	second := make([]float64, n)
+	copy(second[1:], gamma)
+
+	slopes := make([]float64, n)
+	for i := 0; i < n-1; i++ {
+		delta := (values[i+1] - values[i]) / f.h[i]
+		slopes[i] = delta - f.h[i]*(2.0*second[i]+second[i+1])/6.0
+	}
+	delta := (values[n-1] - values[n-2]) / f.h[n-2]
+	slopes[n-1] = delta + f.h[n-2]*(second[n-2]+2.0*second[n-1])/6.0
+
+	return fitted, slopes, nil
+}
@@ -0,0 +1,232 @@
+package interpolator
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testNoisyXYs samples sin with a gaussian noise of the given standard deviation on an irregular grid.
+func testNoisyXYs(n int, noise float64) XYs {
+	rnd := rand.New(rand.NewSource(42))
+	xys := make(XYs, n)
+	for i := range xys {
+		x := 2.0 * math.Pi * (float64(i) + 0.3*rnd.Float64()) / float64(n)
+		xys[i] = XY{X: x, Y: math.Sin(x) + noise*rnd.NormFloat64()}
+	}
+
+	return xys
+}
+
+func TestNewSmoothingSplineErrors(t *testing.T) {
+	_, err := NewSmoothingSpline(XYs{})
+	require.ErrorIs(t, err, ErrNotEnoughPoints)
+
+	_, err = NewSmoothingSpline(XYs{{X: 1.0, Y: 1.0}, {X: 0.0, Y: 1.0}})
+	require.ErrorIs(t, err, ErrUnsorted)
+
+	_, err = NewSmoothingSpline(testLinearXYs, WithWeights([]float64{1.0}))
+	require.ErrorIs(t, err, ErrLengthMismatch)
+
+	_, err = NewSmoothingSpline(testLinearXYs, WithWeights([]float64{1.0, 1.0, 0.0, 1.0, 1.0}))
+	require.ErrorIs(t, err, ErrNonPositive)
+
+	_, err = NewSmoothingSpline(testLinearXYs, WithWeights([]float64{1.0, 1.0, math.NaN(), 1.0, 1.0}))
+	require.ErrorIs(t, err, ErrNotFinite)
+
+	_, err = NewSmoothingSpline(testLinearXYs, WithSmoothing(SmoothingParameter(-1.0)))
+	require.Error(t, err)
+
+	_, err = NewSmoothingSpline(testLinearXYs, WithWeights([]float64{1.0, 1.0, 1.0, 1.0, 1.0}), WithPrepare(KeepFirst))
+	require.Error(t, err)
+}
+
+func TestNewSmoothingSplineFewPoints(t *testing.T) {
+	single, err := NewSmoothingSpline(XYs{{X: 0.0, Y: 1.0}})
+	require.NoError(t, err)
+	assert.InDelta(t, 1.0, single.Value(1.0), 1.0e-15)
+
+	line, err := NewSmoothingSpline(testLinearXYs[:2], WithSmoothing(SmoothingParameter(10.0)))
+	require.NoError(t, err)
+	assert.InDelta(t, testLinearFunc(0.3), line.Value(0.3), 1.0e-12)
+}
+
+func TestSmoothingSplineInterpolation(t *testing.T) {
+	xys := testNoisyXYs(20, 0.1)
+
+	smoothing, err := NewSmoothingSpline(xys, WithSmoothing(SmoothingParameter(0.0)))
+	require.NoError(t, err)
+	spline, err := NewCubicSpline(xys)
+	require.NoError(t, err)
+
+	for _, x := range testGrid(-0.5, 7.0, 101) {
+		assert.InDelta(t, spline.Value(x), smoothing.Value(x), 1.0e-10)
+	}
+	assert.Equal(t, xys, smoothing.Points())
+}
+
+func TestSmoothingSplineRegression(t *testing.T) {
+	xys := testNoisyXYs(20, 0.1)
+	weights := make([]float64, len(xys))
+	for i := range weights {
+		weights[i] = 1.0 + float64(i%3)
+	}
+
+	interpolator, err := NewSmoothingSpline(xys, WithWeights(weights), WithSmoothing(SmoothingParameter(1.0e12)))
+	require.NoError(t, err)
+
+	// The fit tends to the weighted least squares line.
+	var sw, swx, swy, swxx, swxy float64
+	for i, xy := range xys {
+		w := weights[i]
+		sw += w
+		swx += w * xy.X
+		swy += w * xy.Y
+		swxx += w * xy.X * xy.X
+		swxy += w * xy.X * xy.Y
+	}
+	slope := (sw*swxy - swx*swy) / (sw*swxx - swx*swx)
+	intercept := (swy - slope*swx) / sw
+
+	for _, x := range []float64{0.5, 2.0, 5.0} {
+		assert.InDelta(t, intercept+slope*x, interpolator.Value(x), 1.0e-6)
+		assert.InDelta(t, slope, interpolator.Gradient(x), 1.0e-6)
+	}
+}
+
+func TestSmoothingSplineWeights(t *testing.T) {
+	xys := testNoisyXYs(20, 0.1)
+	weights := make([]float64, len(xys))
+	for i := range weights {
+		weights[i] = 1.0
+	}
+	weights[7] = 1.0e9
+
+	interpolator, err := NewSmoothingSpline(xys, WithWeights(weights), WithSmoothing(SmoothingParameter(1.0)))
+	require.NoError(t, err)
+
+	assert.InDelta(t, xys[7].Y, interpolator.Value(xys[7].X), 1.0e-6)
+	assert.Greater(t, math.Abs(xys[8].Y-interpolator.Value(xys[8].X)), 1.0e-3)
+}
+
+func TestSmoothingSplineResidual(t *testing.T) {
+	xys := testNoisyXYs(50, 0.1)
+	target := float64(len(xys)) * 0.1 * 0.1
+
+	interpolator, err := NewSmoothingSpline(xys, WithSmoothing(SmoothingResidual(target)))
+	require.NoError(t, err)
+
+	residual := 0.0
+	for i, xy := range interpolator.Fitted() {
+		d := xy.Y - xys[i].Y
+		residual += d * d
+	}
+	assert.InEpsilon(t, target, residual, 1.0e-4)
+
+	smoother, err := NewSmoothingSpline(xys, WithSmoothing(SmoothingResidual(2.0*target)))
+	require.NoError(t, err)
+	assert.Greater(t, smoother.Smoothing(), interpolator.Smoothing())
+}
+
+func TestSmoothingSplineGCV(t *testing.T) {
+	xys := testNoisyXYs(50, 0.1)
+
+	interpolator, err := NewSmoothingSpline(xys)
+	require.NoError(t, err)
+	assert.Greater(t, interpolator.Smoothing(), 0.0)
+
+	// The smoothed curve is closer to the underlying function than the noisy data.
+	dataError, fitError := 0.0, 0.0
+	for _, xy := range xys {
+		dataError += math.Pow(xy.Y-math.Sin(xy.X), 2.0)
+		fitError += math.Pow(interpolator.Value(xy.X)-math.Sin(xy.X), 2.0)
+	}
+	assert.Less(t, fitError, 0.5*dataError)
+
+	// The selected parameter is a local minimum of the score.
+	fit := newSmoothingFit(xys, testOnes(len(xys)))
+	lambda := interpolator.Smoothing()
+	assert.LessOrEqual(t, fit.gcv(lambda), fit.gcv(1.1*lambda))
+	assert.LessOrEqual(t, fit.gcv(lambda), fit.gcv(lambda/1.1))
+}
+
+func TestSmoothingFitGCV(t *testing.T) {
+	xys := testNoisyXYs(8, 0.1)
+	weights := []float64{1.0, 2.0, 0.5, 1.0, 3.0, 1.0, 1.0, 2.0}
+	lambda := 0.05
+
+	// Compute the trace of the influence matrix column by column.
+	trace := 0.0
+	for i := range xys {
+		unit := xys.Copy()
+		for j := range unit {
+			unit[j].Y = 0.0
+		}
+		unit[i].Y = 1.0
+		_, fitted, _ := newSmoothingFit(unit, weights).solve(lambda)
+		trace += fitted[i]
+	}
+
+	fit := newSmoothingFit(xys, weights)
+	_, fitted, _ := fit.solve(lambda)
+	n := float64(len(xys))
+	expected := n * fit.residual(fitted) / ((n - trace) * (n - trace))
+	assert.InEpsilon(t, expected, fit.gcv(lambda), 1.0e-10)
+}
+
+func TestSmoothingSplineContinuity(t *testing.T) {
+	const h = 1.0e-9
+
+	xys := testNoisyXYs(20, 0.1)
+	interpolator, err := NewSmoothingSpline(xys)
+	require.NoError(t, err)
+
+	assert.InDelta(t, 0.0, interpolator.SecondDerivative(xys[0].X), 1.0e-10)
+	assert.InDelta(t, 0.0, interpolator.SecondDerivative(xys[len(xys)-1].X), 1.0e-10)
+	for _, xy := range interpolator.Fitted()[1 : len(xys)-1] {
+		assert.InDelta(t, xy.Y, interpolator.Value(xy.X), 1.0e-12)
+		assert.InDelta(t, interpolator.Gradient(xy.X), interpolator.Gradient(xy.X-h), 1.0e-6)
+		assert.InDelta(t, interpolator.SecondDerivative(xy.X), interpolator.SecondDerivative(xy.X-h), 1.0e-6)
+	}
+}
+
+// testOnes returns a slice of n ones.
+func testOnes(n int) []float64 {
+	ones := make([]float64, n)
+	for i := range ones {
+		ones[i] = 1.0
+	}
+
+	return ones
+}
+
+func ExampleSmoothingSpline_Value() {
+	xys := XYs{
+		{
+			X: 0.0,
+			Y: 0.1,
+		},
+		{
+			X: 1.0,
+			Y: 0.9,
+		},
+		{
+			X: 2.0,
+			Y: 2.1,
+		},
+		{
+			X: 3.0,
+			Y: 2.9,
+		},
+	}
+	interp, err := NewSmoothingSpline(xys, WithSmoothing(SmoothingParameter(1.0e6)))
+	if err != nil {
+		return
+	}
+	fmt.Printf("%0.4f\n", interp.Value(1.5))
+	// Output: 1.5000
+}
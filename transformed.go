@@ -0,0 +1,252 @@
+package interpolator
+
+import (
+	"fmt"
+	"math"
+)
+
+// Transform is a strictly monotone change of variable applied to the abscissas or the ordinates
+// of the input data before interpolation, see NewTransformed.
+// The zero value is the identity.
+type Transform struct {
+	forward          func(float64) float64
+	inverse          func(float64) float64
+	derivative       func(float64) float64
+	secondDerivative func(float64) float64
+}
+
+// NewTransform builds a transform from the function `forward`, which must be strictly increasing
+// or strictly decreasing and twice differentiable, its inverse and its first and second derivatives.
+func NewTransform(forward, inverse, derivative, secondDerivative func(float64) float64) Transform {
+	return Transform{
+		forward:          forward,
+		inverse:          inverse,
+		derivative:       derivative,
+		secondDerivative: secondDerivative,
+	}
+}
+
+// TransformIdentity leaves the coordinates unchanged.
+func TransformIdentity() Transform {
+	return Transform{}
+}
+
+// TransformLog takes the natural logarithm of positive coordinates.
+func TransformLog() Transform {
+	return NewTransform(
+		math.Log,
+		math.Exp,
+		func(x float64) float64 { return 1.0 / x },
+		func(x float64) float64 { return -1.0 / (x * x) },
+	)
+}
+
+// TransformSqrt takes the square root of non-negative coordinates.
+func TransformSqrt() Transform {
+	return NewTransform(
+		math.Sqrt,
+		func(x float64) float64 { return x * x },
+		func(x float64) float64 { return 0.5 / math.Sqrt(x) },
+		func(x float64) float64 { return -0.25 / (x * math.Sqrt(x)) },
+	)
+}
+
+// TransformSquare takes the square of non-negative coordinates.
+func TransformSquare() Transform {
+	return NewTransform(
+		func(x float64) float64 { return x * x },
+		math.Sqrt,
+		func(x float64) float64 { return 2.0 * x },
+		func(float64) float64 { return 2.0 },
+	)
+}
+
+// TransformLogit takes the logit log(x/(1-x)) of coordinates strictly between 0 and 1,
+// such as probabilities.
+func TransformLogit() Transform {
+	return NewTransform(
+		func(x float64) float64 { return math.Log(x / (1.0 - x)) },
+		func(x float64) float64 { return 1.0 / (1.0 + math.Exp(-x)) },
+		func(x float64) float64 { return 1.0 / (x * (1.0 - x)) },
+		func(x float64) float64 {
+			v := x * (1.0 - x)
+
+			return (2.0*x - 1.0) / (v * v)
+		},
+	)
+}
+
+func (t Transform) apply(x float64) float64 {
+	if t.forward == nil {
+		return x
+	}
+
+	return t.forward(x)
+}
+
+func (t Transform) invert(x float64) float64 {
+	if t.inverse == nil {
+		return x
+	}
+
+	return t.inverse(x)
+}
+
+func (t Transform) gradient(x float64) float64 {
+	if t.derivative == nil {
+		return 1.0
+	}
+
+	return t.derivative(x)
+}
+
+func (t Transform) secondGradient(x float64) float64 {
+	if t.secondDerivative == nil {
+		return 0.0
+	}
+
+	return t.secondDerivative(x)
+}
+
+// Transformed interpolates the data after changes of variable of the abscissas and of the ordinates:
+// the base interpolator is built on the points (tx(x), ty(y)), and f(x) is ty⁻¹(base(tx(x))).
+// For instance, a linear interpolation with log transforms of both coordinates is a power law
+// between consecutive data points.
+type Transformed struct {
+	base   Interpolator
+	x      Transform
+	y      Transform
+	points XYs
+}
+
+// NewTransformed builds a transformed interpolator from the `base` constructor and the transforms
+// of the abscissas `x` and of the ordinates `y`.
+// The input `xys` must be ordered and have unique abscissas, otherwise a *PointError is returned;
+// a point outside of the domain of a transform yields a *PointError wrapping ErrNotTransformable.
+// The options are passed to the base constructor, and thus apply in the transformed coordinates:
+// in particular, the extrapolation is the one of the base interpolator.
+// If the transform of the abscissas is decreasing, the transformed points are reversed, and the options
+// on the left and right sides, such as WithLeftExtrapolation, still apply to the left and right sides of f.
+func NewTransformed(xys XYs, base Constructor, x, y Transform, opts ...Option) (*Transformed, error) {
+	cfg := newConfig(ExtrapolateFlat(), opts)
+
+	xys, err := cfg.prepare("transformed", xys)
+	if err != nil {
+		return nil, err
+	}
+
+	transformed := make(XYs, len(xys))
+	for i, xy := range xys {
+		u, v := x.apply(xy.X), y.apply(xy.Y)
+		if math.IsNaN(u) || math.IsInf(u, 0) || math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil, &PointError{Index: i, Point: xy, Err: ErrNotTransformable}
+		}
+		transformed[i] = XY{X: u, Y: v}
+	}
+	if n := len(transformed); n > 1 && transformed[0].X > transformed[n-1].X {
+		for i, j := 0, n-1; i < j; i, j = i+1, j-1 {
+			transformed[i], transformed[j] = transformed[j], transformed[i]
+		}
+		opts = append(opts[:len(opts):len(opts)], swapSides)
+	}
+
+	interp, err := base(transformed, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("transformed base interpolator: %w", err)
+	}
+
+	return &Transformed{
+		base:   interp,
+		x:      x,
+		y:      y,
+		points: xys,
+	}, nil
+}
+
+// swapSides exchanges the options on the left and right sides, for a decreasing transform of the abscissas.
+func swapSides(cfg *config) {
+	cfg.left, cfg.right = cfg.right, cfg.left
+	cfg.leftBoundary, cfg.rightBoundary = cfg.rightBoundary, cfg.leftBoundary
+}
+
+// TransformedConstructor returns the constructor of transformed interpolators
+// from the `base` constructor and the transforms of the abscissas `x` and of the ordinates `y`,
+// which can be registered as an interpolation method, see Register.
+func TransformedConstructor(base Constructor, x, y Transform) Constructor {
+	return func(xys XYs, opts ...Option) (Interpolator, error) {
+		return NewTransformed(xys, base, x, y, opts...)
+	}
+}
+
+// Value computes ty⁻¹(base(tx(x))).
+// It is NaN if x is outside of the domain of the transform of the abscissas.
+func (interp Transformed) Value(x float64) float64 {
+	u := interp.x.apply(x)
+	if math.IsNaN(u) {
+		return math.NaN()
+	}
+
+	return interp.y.invert(interp.base.Value(u))
+}
+
+// Gradient computes the gradient of f(x) by the chain rule.
+// It is NaN if x is outside of the domain of the transform of the abscissas.
+func (interp Transformed) Gradient(x float64) float64 {
+	u := interp.x.apply(x)
+	if math.IsNaN(u) {
+		return math.NaN()
+	}
+	y := interp.y.invert(interp.base.Value(u))
+
+	return interp.base.Gradient(u) * interp.x.gradient(x) / interp.y.gradient(y)
+}
+
+// SecondDerivative computes the second derivative of f(x) by the chain rule.
+// It is NaN if the base interpolator is not TwiceDifferentiable,
+// or if x is outside of the domain of the transform of the abscissas.
+func (interp Transformed) SecondDerivative(x float64) float64 {
+	twice, ok := interp.base.(TwiceDifferentiable)
+	if !ok {
+		return math.NaN()
+	}
+
+	u := interp.x.apply(x)
+	if math.IsNaN(u) {
+		return math.NaN()
+	}
+	y := interp.y.invert(interp.base.Value(u))
+	du, d2u := interp.x.gradient(x), interp.x.secondGradient(x)
+	dv := interp.base.Gradient(u) * du
+	d2v := twice.SecondDerivative(u)*du*du + interp.base.Gradient(u)*d2u
+
+	// With g the inverse of ty, g'(v) = 1/ty'(y) and g''(v) = -ty''(y)/ty'(y)^3.
+	dy := 1.0 / interp.y.gradient(y)
+
+	return dy*d2v - interp.y.secondGradient(y)*dy*dy*dy*dv*dv
+}
+
+// Inverse returns the abscissa x such that f(x) = y, from the inverse of the base interpolator.
+// An error wrapping ErrNotInvertible is returned if the base interpolator is not Invertible.
+func (interp Transformed) Inverse(y float64) (float64, error) {
+	inv, ok := interp.base.(Invertible)
+	if !ok {
+		return 0.0, fmt.Errorf("%w: base interpolator %T", ErrNotInvertible, interp.base)
+	}
+
+	u, err := inv.Inverse(interp.y.apply(y))
+	if err != nil {
+		return 0.0, err
+	}
+
+	return interp.x.invert(u), nil
+}
+
+// Domain returns the smallest and largest abscissas of the input data.
+func (interp Transformed) Domain() (float64, float64) {
+	return interp.points[0].X, interp.points[len(interp.points)-1].X
+}
+
+// Points returns a copy of the input data, before transformation.
+func (interp Transformed) Points() XYs {
+	return interp.points.Copy()
+}
@@ -0,0 +1,420 @@
+package interpolator
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testPowerXYs are sampled from 2*x^1.5, which is linear in log-log coordinates.
+var testPowerXYs = XYs{
+	{
+		X: 1.0,
+		Y: 2.0,
+	},
+	{
+		X: 2.0,
+		Y: 2.0 * math.Pow(2.0, 1.5),
+	},
+	{
+		X: 4.0,
+		Y: 16.0,
+	},
+	{
+		X: 8.0,
+		Y: 2.0 * math.Pow(8.0, 1.5),
+	},
+}
+
+func testMethodConstructor(method string) Constructor {
+	return func(xys XYs, opts ...Option) (Interpolator, error) {
+		return New(method, xys, opts...)
+	}
+}
+
+func TestNewTransformedEmptyXYs(t *testing.T) {
+	_, err := NewTransformed(XYs{}, testMethodConstructor(MethodPiecewiseLinear), TransformLog(), TransformLog())
+	require.ErrorIs(t, err, ErrNotEnoughPoints)
+}
+
+func TestNewTransformedUnsortedXYs(t *testing.T) {
+	_, err := NewTransformed(XYs{
+		{
+			X: 2.0,
+			Y: 1.0,
+		},
+		{
+			X: 1.0,
+			Y: 1.0,
+		},
+	}, testMethodConstructor(MethodPiecewiseLinear), TransformLog(), TransformLog())
+	require.ErrorIs(t, err, ErrUnsorted)
+}
+
+func TestNewTransformedNotTransformable(t *testing.T) {
+	testCases := []struct {
+		name  string
+		x     Transform
+		y     Transform
+		index int
+	}{
+		{
+			"LogAbscissa",
+			TransformLog(),
+			TransformIdentity(),
+			0,
+		},
+		{
+			"LogOrdinate",
+			TransformIdentity(),
+			TransformLog(),
+			2,
+		},
+		{
+			"LogitOrdinate",
+			TransformIdentity(),
+			TransformLogit(),
+			1,
+		},
+	}
+	xys := XYs{
+		{
+			X: 0.0,
+			Y: 0.5,
+		},
+		{
+			X: 1.0,
+			Y: 1.0,
+		},
+		{
+			X: 2.0,
+			Y: -1.0,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewTransformed(xys, testMethodConstructor(MethodPiecewiseLinear), tc.x, tc.y)
+			require.ErrorIs(t, err, ErrNotTransformable)
+
+			var pointErr *PointError
+			require.ErrorAs(t, err, &pointErr)
+			assert.Equal(t, tc.index, pointErr.Index)
+			assert.Equal(t, xys[tc.index], pointErr.Point)
+		})
+	}
+}
+
+func TestNewTransformedBaseError(t *testing.T) {
+	_, err := NewTransformed(testPowerXYs, testMethodConstructor(MethodCubicSpline), TransformLog(), TransformLog(),
+		WithBoundary(BoundaryPeriodic()))
+	require.ErrorIs(t, err, ErrNotPeriodic)
+}
+
+func TestTransformedPowerLaw(t *testing.T) {
+	const tol = 1.0e-12
+
+	interpolator, err := NewTransformed(testPowerXYs, testMethodConstructor(MethodPiecewiseLinear),
+		TransformLog(), TransformLog(), WithExtrapolation(ExtrapolateEdge()))
+	require.NoError(t, err)
+
+	for _, x := range []float64{0.5, 1.0, 1.5, 3.0, 5.0, 8.0, 16.0} {
+		assert.InEpsilon(t, 2.0*math.Pow(x, 1.5), interpolator.Value(x), tol, "x=%v", x)
+		assert.InEpsilon(t, 3.0*math.Sqrt(x), interpolator.Gradient(x), tol, "x=%v", x)
+		assert.InEpsilon(t, 1.5/math.Sqrt(x), interpolator.SecondDerivative(x), tol, "x=%v", x)
+	}
+
+	x, err := interpolator.Inverse(2.0 * math.Pow(3.0, 1.5))
+	require.NoError(t, err)
+	assert.InDelta(t, 3.0, x, tol)
+
+	xMin, xMax := interpolator.Domain()
+	assert.Equal(t, 1.0, xMin)
+	assert.Equal(t, 8.0, xMax)
+	assert.Equal(t, testPowerXYs, interpolator.Points())
+}
+
+func TestTransformedSqrtAbscissa(t *testing.T) {
+	xys := make(XYs, 5)
+	for i := range xys {
+		x := float64(i * i)
+		xys[i] = XY{X: x, Y: 1.0 + 2.0*math.Sqrt(x)}
+	}
+
+	interpolator, err := NewTransformed(xys, testMethodConstructor(MethodPiecewiseLinear), TransformSqrt(), TransformIdentity())
+	require.NoError(t, err)
+
+	for _, x := range []float64{0.5, 2.0, 7.0, 15.0} {
+		assert.InDelta(t, 1.0+2.0*math.Sqrt(x), interpolator.Value(x), 1.0e-12, "x=%v", x)
+		assert.InDelta(t, 1.0/math.Sqrt(x), interpolator.Gradient(x), 1.0e-12, "x=%v", x)
+		assert.InDelta(t, -0.5/(x*math.Sqrt(x)), interpolator.SecondDerivative(x), 1.0e-12, "x=%v", x)
+	}
+}
+
+func TestTransformedDecreasing(t *testing.T) {
+	reciprocal := NewTransform(
+		func(x float64) float64 { return 1.0 / x },
+		func(x float64) float64 { return 1.0 / x },
+		func(x float64) float64 { return -1.0 / (x * x) },
+		func(x float64) float64 { return 2.0 / (x * x * x) },
+	)
+
+	// 3 + 2/x is linear in 1/x, and 1/(1+x) is the reciprocal of a linear function.
+	xys := make(XYs, 4)
+	for i := range xys {
+		x := math.Pow(2.0, float64(i))
+		xys[i] = XY{X: x, Y: 3.0 + 2.0/x}
+	}
+	interpolator, err := NewTransformed(xys, testMethodConstructor(MethodPiecewiseLinear), reciprocal, TransformIdentity(),
+		WithLeftExtrapolation(ExtrapolateConstant(-1.0)), WithRightExtrapolation(ExtrapolateConstant(10.0)))
+	require.NoError(t, err)
+
+	for _, x := range []float64{1.0, 1.5, 3.0, 8.0} {
+		assert.InDelta(t, 3.0+2.0/x, interpolator.Value(x), 1.0e-12, "x=%v", x)
+		assert.InDelta(t, -2.0/(x*x), interpolator.Gradient(x), 1.0e-12, "x=%v", x)
+		assert.InDelta(t, 4.0/(x*x*x), interpolator.SecondDerivative(x), 1.0e-12, "x=%v", x)
+	}
+	assert.InDelta(t, -1.0, interpolator.Value(0.5), 1.0e-12)
+	assert.InDelta(t, 10.0, interpolator.Value(16.0), 1.0e-12)
+
+	x, err := interpolator.Inverse(4.0)
+	require.NoError(t, err)
+	assert.InDelta(t, 2.0, x, 1.0e-12)
+
+	for i := range xys {
+		xys[i].Y = 1.0 / (1.0 + xys[i].X)
+	}
+	interpolator, err = NewTransformed(xys, testMethodConstructor(MethodPiecewiseLinear), TransformIdentity(), reciprocal)
+	require.NoError(t, err)
+
+	for _, x := range []float64{1.0, 1.5, 3.0, 8.0} {
+		assert.InDelta(t, 1.0/(1.0+x), interpolator.Value(x), 1.0e-12, "x=%v", x)
+		assert.InDelta(t, -1.0/((1.0+x)*(1.0+x)), interpolator.Gradient(x), 1.0e-12, "x=%v", x)
+	}
+}
+
+func TestTransformedOutsideAbscissaTransform(t *testing.T) {
+	testCases := []struct {
+		name string
+		x    Transform
+	}{
+		{
+			"Log",
+			TransformLog(),
+		},
+		{
+			"Sqrt",
+			TransformSqrt(),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			interpolator, err := NewTransformed(testPowerXYs, testMethodConstructor(MethodPiecewiseLinear), tc.x, TransformIdentity(),
+				WithExtrapolation(ExtrapolateFlat()))
+			require.NoError(t, err)
+
+			for _, x := range []float64{-1.0, -1.0e-9, math.Inf(-1), math.NaN()} {
+				assert.True(t, math.IsNaN(interpolator.Value(x)), "x=%v", x)
+				assert.True(t, math.IsNaN(interpolator.Gradient(x)), "x=%v", x)
+				assert.True(t, math.IsNaN(interpolator.SecondDerivative(x)), "x=%v", x)
+			}
+
+			// The origin is at the boundary of the domain of the transforms, where the extrapolation is flat.
+			assert.Equal(t, testPowerXYs[0].Y, interpolator.Value(0.0))
+		})
+	}
+}
+
+func TestTransformedMatchesGeometric(t *testing.T) {
+	const tol = 1.0e-12
+
+	transformed, err := NewTransformed(testExpXYs, testMethodConstructor(MethodPiecewiseLinear), TransformIdentity(), TransformLog())
+	require.NoError(t, err)
+	geometric, err := NewGeometric(testExpXYs)
+	require.NoError(t, err)
+
+	for _, x := range testGrid(0.0, 2.0, 41) {
+		assert.InDelta(t, geometric.Value(x), transformed.Value(x), tol, "x=%v", x)
+		assert.InDelta(t, geometric.Gradient(x), transformed.Gradient(x), tol, "x=%v", x)
+		assert.InDelta(t, geometric.SecondDerivative(x), transformed.SecondDerivative(x), tol, "x=%v", x)
+	}
+}
+
+func TestTransformedIdentity(t *testing.T) {
+	transformed, err := NewTransformed(testLinearXYs, testMethodConstructor(MethodCubicSpline), Transform{}, TransformIdentity())
+	require.NoError(t, err)
+	spline, err := NewCubicSpline(testLinearXYs)
+	require.NoError(t, err)
+
+	for _, x := range testGrid(-1.0, 3.0, 41) {
+		assert.Equal(t, spline.Value(x), transformed.Value(x))
+		assert.Equal(t, spline.Gradient(x), transformed.Gradient(x))
+		assert.Equal(t, spline.SecondDerivative(x), transformed.SecondDerivative(x))
+	}
+}
+
+func TestTransformedLogitBounded(t *testing.T) {
+	xys := XYs{
+		{
+			X: 0.0,
+			Y: 0.1,
+		},
+		{
+			X: 1.0,
+			Y: 0.5,
+		},
+		{
+			X: 2.0,
+			Y: 0.9,
+		},
+		{
+			X: 3.0,
+			Y: 0.95,
+		},
+	}
+
+	interpolator, err := NewTransformed(xys, testMethodConstructor(MethodCubicSpline), TransformIdentity(), TransformLogit())
+	require.NoError(t, err)
+
+	for _, xy := range xys {
+		assert.InDelta(t, xy.Y, interpolator.Value(xy.X), 1.0e-12)
+	}
+	for _, x := range testGrid(-1.0, 4.0, 101) {
+		y := interpolator.Value(x)
+		assert.Greater(t, y, 0.0, "x=%v", x)
+		assert.Less(t, y, 1.0, "x=%v", x)
+	}
+}
+
+func TestTransformedDerivatives(t *testing.T) {
+	const h = 1.0e-5
+
+	testCases := []struct {
+		name string
+		x    Transform
+		y    Transform
+	}{
+		{
+			"LogLog",
+			TransformLog(),
+			TransformLog(),
+		},
+		{
+			"SqrtSquare",
+			TransformSqrt(),
+			TransformSquare(),
+		},
+		{
+			"IdentityLogit",
+			TransformIdentity(),
+			TransformLogit(),
+		},
+	}
+	xys := XYs{
+		{
+			X: 1.0,
+			Y: 0.2,
+		},
+		{
+			X: 2.0,
+			Y: 0.3,
+		},
+		{
+			X: 4.0,
+			Y: 0.7,
+		},
+		{
+			X: 5.0,
+			Y: 0.6,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			interpolator, err := NewTransformed(xys, testMethodConstructor(MethodCubicSpline), tc.x, tc.y)
+			require.NoError(t, err)
+
+			for _, x := range []float64{1.3, 2.5, 3.7, 4.6} {
+				gradient := (interpolator.Value(x+h) - interpolator.Value(x-h)) / (2.0 * h)
+				second := (interpolator.Gradient(x+h) - interpolator.Gradient(x-h)) / (2.0 * h)
+				assert.InDelta(t, gradient, interpolator.Gradient(x), 1.0e-7, "x=%v", x)
+				assert.InDelta(t, second, interpolator.SecondDerivative(x), 1.0e-6, "x=%v", x)
+			}
+		})
+	}
+}
+
+func TestTransformedNotInvertible(t *testing.T) {
+	interpolator, err := NewTransformed(testPowerXYs, testMethodConstructor(MethodPiecewiseConstant), TransformLog(), TransformLog())
+	require.NoError(t, err)
+
+	_, err = interpolator.Inverse(3.0)
+	require.ErrorIs(t, err, ErrNotInvertible)
+}
+
+func TestTransformedNotTwiceDifferentiable(t *testing.T) {
+	base := func(xys XYs, opts ...Option) (Interpolator, error) {
+		interp, err := NewPiecewiseLinear(xys, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		return struct{ Interpolator }{interp}, nil
+	}
+
+	interpolator, err := NewTransformed(testPowerXYs, base, TransformLog(), TransformLog())
+	require.NoError(t, err)
+	assert.InEpsilon(t, 2.0*math.Pow(3.0, 1.5), interpolator.Value(3.0), 1.0e-12)
+	assert.True(t, math.IsNaN(interpolator.SecondDerivative(3.0)))
+}
+
+func TestTransformedConstructor(t *testing.T) {
+	constructor := TransformedConstructor(testMethodConstructor(MethodPiecewiseLinear), TransformLog(), TransformLog())
+	require.NoError(t, Register("test_log_log", constructor))
+
+	interp, err := New("test_log_log", testPowerXYs)
+	require.NoError(t, err)
+	assert.InEpsilon(t, 2.0*math.Pow(3.0, 1.5), interp.Value(3.0), 1.0e-12)
+}
+
+func ExampleNewTransformed() {
+	linear := func(xys XYs, opts ...Option) (Interpolator, error) {
+		return NewPiecewiseLinear(xys, opts...)
+	}
+	xys := XYs{
+		{
+			X: 1.0,
+			Y: 1.0,
+		},
+		{
+			X: 4.0,
+			Y: 8.0,
+		},
+	}
+	interp, err := NewTransformed(xys, linear, TransformLog(), TransformLog())
+	if err != nil {
+		return
+	}
+	fmt.Printf("%.4f\n", interp.Value(2.0))
+	// Output: 2.8284
+}
+
+func BenchmarkTransformedValue(b *testing.B) {
+	interpolator, err := NewTransformed(testPowerXYs, testMethodConstructor(MethodPiecewiseLinear), TransformLog(), TransformLog())
+	require.NoError(b, err)
+	var (
+		x = 4.0
+		y = 16.0
+		v float64
+	)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		v = interpolator.Value(x)
+	}
+	b.StopTimer()
+	assert.InDelta(b, y, v, 1.0e-12)
+}
@@ -1,6 +1,10 @@
 package interpolator
 
-import "sort"
+import (
+	"fmt"
+	"math"
+	"sort"
+)
 
 // XY represent a 2-dimensional data point.
 type XY struct {
@@ -11,8 +15,26 @@ type XY struct {
 // XYs represents a slice of data points.
 type XYs []XY
 
+// NewXYs builds the data points from separate slices of abscissas and ordinates,
+// which must have the same length.
+func NewXYs(xs, ys []float64) (XYs, error) {
+	if len(xs) != len(ys) {
+		return nil, fmt.Errorf("%w: got %d abscissas and %d ordinates", ErrLengthMismatch, len(xs), len(ys))
+	}
+
+	xys := make(XYs, len(xs))
+	for i := range xs {
+		xys[i] = XY{
+			X: xs[i],
+			Y: ys[i],
+		}
+	}
+
+	return xys, nil
+}
+
 // Interval returns the bracketing points around x for a given XYs.
-// The `xys` must be ordered and have unique abscissas.
+// The `xys` must be ordered and have unique abscissas, see Validate.
 func (xys XYs) Interval(x float64) (XY, XY) {
 	n := len(xys)
 	if x <= xys[0].X {
@@ -25,3 +47,133 @@ func (xys XYs) Interval(x float64) (XY, XY) {
 
 	return xys[upperBound-1], xys[upperBound]
 }
+
+// Domain returns the abscissas of the first and last points of a given XYs.
+func (xys XYs) Domain() (float64, float64) {
+	return xys[0].X, xys[len(xys)-1].X
+}
+
+// Copy returns a copy of the XYs which does not share its underlying array.
+func (xys XYs) Copy() XYs {
+	return append(XYs(nil), xys...)
+}
+
+// Validate checks that the XYs can be used to build an interpolator:
+// all coordinates must be finite, and the abscissas must be
+// in strictly increasing order.
+// The returned error is a *PointError wrapping one of ErrNotFinite,
+// ErrUnsorted or ErrDuplicateAbscissa.
+func (xys XYs) Validate() error {
+	return xys.validate(false)
+}
+
+// validate is Validate, allowing pairs of consecutive points sharing an abscissa
+// strictly inside the domain if `jumps` is set.
+func (xys XYs) validate(jumps bool) error {
+	for i, xy := range xys {
+		if math.IsNaN(xy.X) || math.IsInf(xy.X, 0) || math.IsNaN(xy.Y) || math.IsInf(xy.Y, 0) {
+			return &PointError{Index: i, Point: xy, Err: ErrNotFinite}
+		}
+		if i == 0 {
+			continue
+		}
+		switch prev := xys[i-1].X; {
+		case xy.X == prev:
+			if !jumps || i == 1 || i == len(xys)-1 || xys[i-2].X == prev {
+				return &PointError{Index: i, Point: xy, Err: ErrDuplicateAbscissa}
+			}
+		case xy.X < prev:
+			return &PointError{Index: i, Point: xy, Err: ErrUnsorted}
+		}
+	}
+
+	return nil
+}
+
+// validatePositive checks that all the ordinates are greater than epsilon.
+func (xys XYs) validatePositive() error {
+	for i, xy := range xys {
+		if xy.Y < epsilon {
+			return &PointError{Index: i, Point: xy, Err: ErrNonPositive}
+		}
+	}
+
+	return nil
+}
+
+// Sort sorts the XYs in place by increasing abscissas.
+// The sort is stable, so that points sharing the same abscissa keep their relative order.
+func (xys XYs) Sort() {
+	sort.SliceStable(xys, func(i, j int) bool { return xys[i].X < xys[j].X })
+}
+
+// DuplicatePolicy defines how points sharing the same abscissa are merged.
+type DuplicatePolicy int
+
+const (
+	// RejectDuplicates returns an ErrDuplicateAbscissa error on duplicate abscissas.
+	RejectDuplicates DuplicatePolicy = iota
+	// KeepFirst keeps the first of the points sharing the same abscissa.
+	KeepFirst
+	// KeepLast keeps the last of the points sharing the same abscissa.
+	KeepLast
+	// Average replaces the points sharing the same abscissa by the average of their ordinates.
+	Average
+)
+
+// Deduplicate returns a new XYs where consecutive points sharing the same abscissa
+// are merged according to the given policy.
+// The `xys` must be ordered, see Sort.
+func (xys XYs) Deduplicate(policy DuplicatePolicy) (XYs, error) {
+	res := make(XYs, 0, len(xys))
+	for i := 0; i < len(xys); {
+		j := i + 1
+		for j < len(xys) && xys[j].X == xys[i].X {
+			j++
+		}
+
+		xy := xys[i]
+		if j-i > 1 {
+			switch policy {
+			case RejectDuplicates:
+				return nil, &PointError{Index: i + 1, Point: xys[i+1], Err: ErrDuplicateAbscissa}
+			case KeepFirst:
+			case KeepLast:
+				xy = xys[j-1]
+			case Average:
+				sum := 0.0
+				for _, dup := range xys[i:j] {
+					sum += dup.Y
+				}
+				xy.Y = sum / float64(j-i)
+			default:
+				return nil, fmt.Errorf("unknown duplicate policy %d", policy)
+			}
+		}
+
+		res = append(res, xy)
+		i = j
+	}
+
+	return res, nil
+}
+
+// Prepare turns arbitrary data points into valid input for the interpolators:
+// it returns a sorted copy of the XYs, where duplicate abscissas are merged
+// according to the given policy.
+// The input is left untouched. An error is returned if a coordinate is not finite.
+func (xys XYs) Prepare(policy DuplicatePolicy) (XYs, error) {
+	sorted := xys.Copy()
+	sorted.Sort()
+
+	res, err := sorted.Deduplicate(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := res.Validate(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
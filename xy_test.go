@@ -2,9 +2,11 @@ package interpolator
 
 import (
 	"fmt"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func testLinearFunc(x float64) float64 {
@@ -109,3 +111,142 @@ func ExampleXYs_Interval() {
 	fmt.Println(xys.Interval(0.75))
 	// Output: {0.5 1} {1 1.4}
 }
+
+func TestXYsDomain(t *testing.T) {
+	xMin, xMax := testLinearXYs.Domain()
+
+	assert.InDelta(t, 0.0, xMin, 1.0e-15)
+	assert.InDelta(t, 2.0, xMax, 1.0e-15)
+}
+
+func TestXYsCopy(t *testing.T) {
+	xys := testLinearXYs.Copy()
+	assert.Equal(t, testLinearXYs, xys)
+
+	xys[0].Y = 100.0
+	assert.NotEqual(t, testLinearXYs[0], xys[0])
+}
+
+func TestXYsValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    XYs
+		index    int
+		expected error
+	}{
+		{
+			"Unsorted",
+			XYs{{X: 0.0, Y: 1.0}, {X: 1.0, Y: 2.0}, {X: 0.5, Y: 3.0}},
+			2,
+			ErrUnsorted,
+		},
+		{
+			"DuplicateAbscissa",
+			XYs{{X: 0.0, Y: 1.0}, {X: 1.0, Y: 2.0}, {X: 1.0, Y: 3.0}},
+			2,
+			ErrDuplicateAbscissa,
+		},
+		{
+			"NaNAbscissa",
+			XYs{{X: 0.0, Y: 1.0}, {X: math.NaN(), Y: 2.0}},
+			1,
+			ErrNotFinite,
+		},
+		{
+			"InfiniteOrdinate",
+			XYs{{X: 0.0, Y: math.Inf(-1)}, {X: 1.0, Y: 2.0}},
+			0,
+			ErrNotFinite,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.input.Validate()
+			require.ErrorIs(t, err, tc.expected)
+
+			var pointErr *PointError
+			require.ErrorAs(t, err, &pointErr)
+			assert.Equal(t, tc.index, pointErr.Index)
+		})
+	}
+
+	require.NoError(t, testLinearXYs.Validate())
+}
+
+func TestNewXYs(t *testing.T) {
+	xys, err := NewXYs([]float64{0.0, 1.0}, []float64{2.0, 3.0})
+	require.NoError(t, err)
+	assert.Equal(t, XYs{{X: 0.0, Y: 2.0}, {X: 1.0, Y: 3.0}}, xys)
+
+	_, err = NewXYs([]float64{0.0, 1.0}, []float64{2.0})
+	require.ErrorIs(t, err, ErrLengthMismatch)
+}
+
+func TestXYsSort(t *testing.T) {
+	xys := XYs{{X: 1.0, Y: 1.0}, {X: 0.0, Y: 2.0}, {X: 1.0, Y: 3.0}}
+	xys.Sort()
+
+	assert.Equal(t, XYs{{X: 0.0, Y: 2.0}, {X: 1.0, Y: 1.0}, {X: 1.0, Y: 3.0}}, xys)
+}
+
+func TestXYsPrepare(t *testing.T) {
+	data := XYs{{X: 1.0, Y: 1.0}, {X: 0.0, Y: 2.0}, {X: 1.0, Y: 3.0}, {X: 2.0, Y: 4.0}}
+
+	testCases := []struct {
+		name     string
+		policy   DuplicatePolicy
+		expected XYs
+	}{
+		{
+			"KeepFirst",
+			KeepFirst,
+			XYs{{X: 0.0, Y: 2.0}, {X: 1.0, Y: 1.0}, {X: 2.0, Y: 4.0}},
+		},
+		{
+			"KeepLast",
+			KeepLast,
+			XYs{{X: 0.0, Y: 2.0}, {X: 1.0, Y: 3.0}, {X: 2.0, Y: 4.0}},
+		},
+		{
+			"Average",
+			Average,
+			XYs{{X: 0.0, Y: 2.0}, {X: 1.0, Y: 2.0}, {X: 2.0, Y: 4.0}},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			xys, err := data.Prepare(tc.policy)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, xys)
+		})
+	}
+
+	_, err := data.Prepare(RejectDuplicates)
+	require.ErrorIs(t, err, ErrDuplicateAbscissa)
+
+	assert.Equal(t, XYs{{X: 1.0, Y: 1.0}, {X: 0.0, Y: 2.0}, {X: 1.0, Y: 3.0}, {X: 2.0, Y: 4.0}}, data)
+}
+
+func ExampleXYs_Prepare() {
+	xys, err := XYs{
+		{
+			X: 1.0,
+			Y: 1.0,
+		},
+		{
+			X: 0.0,
+			Y: 2.0,
+		},
+		{
+			X: 1.0,
+			Y: 3.0,
+		},
+	}.Prepare(Average)
+	if err != nil {
+		return
+	}
+	fmt.Println(xys)
+	// Output: [{0 2} {1 2}]
+}
@@ -0,0 +1,114 @@
+package interpolator
+
+import (
+	"fmt"
+	"math"
+)
+
+// XYD represents a 2-dimensional data point along with the first derivative of the curve at this point.
+type XYD struct {
+	X float64
+	Y float64
+	D float64
+}
+
+// XYDs represents a slice of data points with derivatives.
+type XYDs []XYD
+
+// NewXYDs builds the data points from separate slices of abscissas, ordinates and derivatives,
+// which must have the same length.
+func NewXYDs(xs, ys, ds []float64) (XYDs, error) {
+	if len(xs) != len(ys) || len(xs) != len(ds) {
+		return nil, fmt.Errorf("%w: got %d abscissas, %d ordinates and %d derivatives", ErrLengthMismatch, len(xs), len(ys), len(ds))
+	}
+
+	xyds := make(XYDs, len(xs))
+	for i := range xs {
+		xyds[i] = XYD{
+			X: xs[i],
+			Y: ys[i],
+			D: ds[i],
+		}
+	}
+
+	return xyds, nil
+}
+
+// Copy returns a copy of the XYDs which does not share its underlying array.
+func (xyds XYDs) Copy() XYDs {
+	return append(XYDs(nil), xyds...)
+}
+
+// XYs returns the data points without their derivatives.
+func (xyds XYDs) XYs() XYs {
+	xys, _ := xyds.split()
+
+	return xys
+}
+
+// split returns the data points and their derivatives as separate slices.
+func (xyds XYDs) split() (XYs, []float64) {
+	xys := make(XYs, len(xyds))
+	ds := make([]float64, len(xyds))
+	for i, xyd := range xyds {
+		xys[i] = XY{X: xyd.X, Y: xyd.Y}
+		ds[i] = xyd.D
+	}
+
+	return xys, ds
+}
+
+// Validate checks that the XYDs can be used to build an interpolator:
+// all coordinates and derivatives must be finite, and the abscissas must be
+// in strictly increasing order.
+// The returned error is a *PointError wrapping one of ErrNotFinite,
+// ErrUnsorted or ErrDuplicateAbscissa.
+func (xyds XYDs) Validate() error {
+	if err := xyds.XYs().Validate(); err != nil {
+		return err
+	}
+
+	for i, xyd := range xyds {
+		if math.IsNaN(xyd.D) || math.IsInf(xyd.D, 0) {
+			return &PointError{Index: i, Point: XY{X: xyd.X, Y: xyd.Y}, Err: fmt.Errorf("%w: derivative %v", ErrNotFinite, xyd.D)}
+		}
+	}
+
+	return nil
+}
+
+// Prepare turns arbitrary data points with derivatives into valid input for the interpolators:
+// it returns a sorted copy of the XYDs, where duplicate abscissas are merged
+// according to the given policy, which applies to both the ordinates and the derivatives.
+// The input is left untouched. An error is returned if a coordinate or a derivative is not finite.
+func (xyds XYDs) Prepare(policy DuplicatePolicy) (XYDs, error) {
+	xys, ds := xyds.split()
+	dxs := make(XYs, len(xyds))
+	for i, xyd := range xyds {
+		dxs[i] = XY{X: xyd.X, Y: ds[i]}
+	}
+
+	// Both slices share the same abscissas, so that they are sorted and merged identically.
+	xys.Sort()
+	dxs.Sort()
+
+	xys, err := xys.Deduplicate(policy)
+	if err != nil {
+		return nil, err
+	}
+	dxs, err = dxs.Deduplicate(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(XYDs, len(xys))
+	for i := range xys {
+		res[i] = XYD{X: xys[i].X, Y: xys[i].Y, D: dxs[i].Y}
+	}
+
+	if err := res.Validate(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
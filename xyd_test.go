@@ -0,0 +1,75 @@
+package interpolator
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewXYDs(t *testing.T) {
+	xyds, err := NewXYDs([]float64{0.0, 1.0}, []float64{1.0, 2.0}, []float64{0.5, -0.5})
+	require.NoError(t, err)
+	assert.Equal(t, XYDs{{X: 0.0, Y: 1.0, D: 0.5}, {X: 1.0, Y: 2.0, D: -0.5}}, xyds)
+	assert.Equal(t, XYs{{X: 0.0, Y: 1.0}, {X: 1.0, Y: 2.0}}, xyds.XYs())
+
+	_, err = NewXYDs([]float64{0.0, 1.0}, []float64{1.0, 2.0}, []float64{0.5})
+	require.ErrorIs(t, err, ErrLengthMismatch)
+}
+
+func TestXYDsValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		xyds     XYDs
+		index    int
+		expected error
+	}{
+		{
+			"NotFiniteDerivative",
+			XYDs{{X: 0.0, Y: 1.0, D: 0.0}, {X: 1.0, Y: 2.0, D: math.Inf(1)}},
+			1,
+			ErrNotFinite,
+		},
+		{
+			"Unsorted",
+			XYDs{{X: 1.0, Y: 1.0, D: 0.0}, {X: 0.0, Y: 2.0, D: 0.0}},
+			1,
+			ErrUnsorted,
+		},
+		{
+			"DuplicateAbscissa",
+			XYDs{{X: 0.0, Y: 1.0, D: 0.0}, {X: 0.0, Y: 2.0, D: 0.0}},
+			1,
+			ErrDuplicateAbscissa,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.xyds.Validate()
+			require.ErrorIs(t, err, tc.expected)
+
+			var pointErr *PointError
+			require.ErrorAs(t, err, &pointErr)
+			assert.Equal(t, tc.index, pointErr.Index)
+		})
+	}
+}
+
+func TestXYDsPrepare(t *testing.T) {
+	xyds := XYDs{{X: 1.0, Y: 2.0, D: 4.0}, {X: 0.0, Y: 1.0, D: 0.0}, {X: 1.0, Y: 4.0, D: 2.0}}
+
+	prepared, err := xyds.Prepare(Average)
+	require.NoError(t, err)
+	assert.Equal(t, XYDs{{X: 0.0, Y: 1.0, D: 0.0}, {X: 1.0, Y: 3.0, D: 3.0}}, prepared)
+
+	prepared, err = xyds.Prepare(KeepLast)
+	require.NoError(t, err)
+	assert.Equal(t, XYDs{{X: 0.0, Y: 1.0, D: 0.0}, {X: 1.0, Y: 4.0, D: 2.0}}, prepared)
+
+	assert.Equal(t, XYD{X: 1.0, Y: 2.0, D: 4.0}, xyds[0])
+
+	_, err = xyds.Prepare(RejectDuplicates)
+	require.ErrorIs(t, err, ErrDuplicateAbscissa)
+}